@@ -1,14 +1,13 @@
 package api
 
 import (
+	"os"
 	"time"
 
 	"github.com/spf13/viper"
-	"github.com/zalando/go-keyring"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
-	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
 )
 
 const clientTimeout = 15 * time.Second
@@ -27,23 +26,13 @@ func Client(config jira.Config) *jira.Client {
 	if config.Login == "" {
 		config.Login = viper.GetString("login")
 	}
-	if config.APIToken == "" {
-		config.APIToken = viper.GetString("api_token")
-	}
-	if config.APIToken == "" {
-		netrcConfig, _ := netrc.Read(config.Server, config.Login)
-		if netrcConfig != nil {
-			config.APIToken = netrcConfig.Password
-		}
-	}
-	if config.APIToken == "" {
-		secret, _ := keyring.Get("jira-cli", config.Login)
-		config.APIToken = secret
-	}
 	if config.AuthType == nil {
 		authType := jira.AuthType(viper.GetString("auth_type"))
 		config.AuthType = &authType
 	}
+	if config.APIToken == "" && *config.AuthType != jira.AuthTypeOAuth2 {
+		config.APIToken = ResolveToken(config.Server, config.Login).Value
+	}
 	if config.Insecure == nil {
 		insecure := viper.GetBool("insecure")
 		config.Insecure = &insecure
@@ -61,11 +50,27 @@ func Client(config jira.Config) *jira.Client {
 		config.MTLSConfig.ClientKey = viper.GetString("mtls.client_key")
 	}
 
-	jiraClient = jira.NewClient(
-		config,
+	opts := []jira.ClientFunc{
 		jira.WithTimeout(clientTimeout),
 		jira.WithInsecureTLS(*config.Insecure),
-	)
+	}
+	switch helper := CredentialHelper(); {
+	case *config.AuthType == jira.AuthTypeOAuth2:
+		var oauth2Opts []jira.ClientFunc
+		config.Server, oauth2Opts = oauth2ClientOptions(config.Server)
+		opts = append(opts, oauth2Opts...)
+	case helper != "" && os.Getenv("JIRA_API_TOKEN") == "":
+		server, login := config.Server, config.Login
+		opts = append(opts, jira.WithTokenRefresher(func() (string, error) {
+			t, err := credentialHelperToken(helper, server, login, true)
+			if err != nil {
+				return "", err
+			}
+			return t.Value, nil
+		}))
+	}
+
+	jiraClient = jira.NewClient(config, opts...)
 
 	return jiraClient
 }
@@ -228,3 +233,23 @@ func ProxyWatchIssue(c *jira.Client, key string, user *jira.User) error {
 	}
 	return c.WatchIssue(key, assignee)
 }
+
+// ProxyJQLValidate uses either the v3 POST /jql/parse endpoint or the v2 GET /search
+// endpoint to validate the query based on configured installation type.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyJQLValidate(c *jira.Client, jql string) (*jira.JQLParseResult, error) {
+	it := viper.GetString("installation")
+
+	if it == jira.InstallationTypeLocal {
+		return c.JQLValidateV2(jql)
+	}
+
+	res, err := c.JQLParse(jql)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, jira.ErrEmptyResponse
+	}
+	return res[0], nil
+}
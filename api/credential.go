@@ -0,0 +1,126 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	shellquote "github.com/kballard/go-shellquote"
+	"github.com/spf13/viper"
+)
+
+const (
+	credentialHelperTimeout = 2 * time.Minute
+	// Tokens are considered expired a bit earlier to avoid
+	// using a token that expires in the middle of a request.
+	credentialExpirySkew = 30 * time.Second
+)
+
+var credentialCache = struct {
+	sync.Mutex
+	tokens map[string]*Token
+}{tokens: make(map[string]*Token)}
+
+// credentialHelperOutput is the JSON output of the credential helper.
+type credentialHelperOutput struct {
+	Token  string    `json:"token"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// CredentialHelper returns the credential helper command set in the config.
+func CredentialHelper() string {
+	return strings.TrimSpace(viper.GetString("credential_helper"))
+}
+
+// credentialHelperToken returns the token supplied by the credential helper.
+// The token is cached for the process lifetime or until it expires. Use
+// refresh to skip the cache, eg: when the server rejects the cached token.
+func credentialHelperToken(helper, server, login string, refresh bool) (*Token, error) {
+	key := server + "\x00" + login
+
+	credentialCache.Lock()
+	defer credentialCache.Unlock()
+
+	if t, ok := credentialCache.tokens[key]; ok && !refresh {
+		if t.Expiry == nil || time.Now().Add(credentialExpirySkew).Before(*t.Expiry) {
+			return t, nil
+		}
+	}
+
+	t, err := runCredentialHelper(helper, server, login)
+	if err != nil {
+		delete(credentialCache.tokens, key)
+		return nil, err
+	}
+	credentialCache.tokens[key] = t
+
+	return t, nil
+}
+
+// runCredentialHelper executes the helper with the `get` argument and passes
+// the request details to stdin in git-credential format. The helper can print
+// either a plain token or a JSON object with token and an optional expiry.
+func runCredentialHelper(helper, server, login string) (*Token, error) {
+	args, err := shellquote.Split(helper)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credential helper: %w", err)
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("credential helper is empty")
+	}
+	args = append(args, "get")
+
+	ctx, cancel := context.WithTimeout(context.Background(), credentialHelperTimeout)
+	defer cancel()
+
+	var stdin, stdout bytes.Buffer
+
+	if u, err := url.Parse(server); err == nil {
+		_, _ = fmt.Fprintf(&stdin, "protocol=%s\nhost=%s\n", u.Scheme, u.Host)
+	}
+	_, _ = fmt.Fprintf(&stdin, "username=%s\n\n", login)
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...) //nolint:gosec
+	cmd.Stdin = &stdin
+	cmd.Stdout = &stdout
+	// Helpers may need to prompt the user, eg: to unlock a vault or to complete SSO.
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential helper %q failed: %w", args[0], err)
+	}
+
+	return parseCredentialHelperOutput(stdout.Bytes())
+}
+
+func parseCredentialHelperOutput(out []byte) (*Token, error) {
+	out = bytes.TrimSpace(out)
+	if len(out) == 0 {
+		return nil, fmt.Errorf("credential helper returned an empty token")
+	}
+
+	if out[0] != '{' {
+		return &Token{Value: string(out), Source: TokenSourceHelper}, nil
+	}
+
+	var o credentialHelperOutput
+	if err := json.Unmarshal(out, &o); err != nil {
+		return nil, fmt.Errorf("invalid credential helper output: %w", err)
+	}
+	if o.Token == "" {
+		return nil, fmt.Errorf("credential helper returned an empty token")
+	}
+
+	t := Token{Value: o.Token, Source: TokenSourceHelper}
+	if !o.Expiry.IsZero() {
+		t.Expiry = &o.Expiry
+	}
+	return &t, nil
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCredentialHelperOutput(t *testing.T) {
+	t.Parallel()
+
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		out      string
+		expected *Token
+		err      bool
+	}{
+		{
+			name:     "plain token",
+			out:      "secret\n",
+			expected: &Token{Value: "secret", Source: TokenSourceHelper},
+		},
+		{
+			name:     "json without expiry",
+			out:      `{"token": "secret"}`,
+			expected: &Token{Value: "secret", Source: TokenSourceHelper},
+		},
+		{
+			name:     "json with expiry",
+			out:      `{"token": "secret", "expiry": "2030-01-02T03:04:05Z"}`,
+			expected: &Token{Value: "secret", Source: TokenSourceHelper, Expiry: &expiry},
+		},
+		{
+			name: "empty output",
+			out:  "  \n",
+			err:  true,
+		},
+		{
+			name: "json without token",
+			out:  `{"expiry": "2030-01-02T03:04:05Z"}`,
+			err:  true,
+		},
+		{
+			name: "invalid json",
+			out:  `{"token": `,
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := parseCredentialHelperOutput([]byte(tc.out))
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestCredentialHelperToken(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("helper script requires a posix shell")
+	}
+
+	dir := t.TempDir()
+	calls := filepath.Join(dir, "calls")
+	input := filepath.Join(dir, "input")
+	helper := filepath.Join(dir, "helper.sh")
+
+	script := `#!/bin/sh
+[ "$1" = "get" ] || exit 1
+cat > "` + input + `"
+echo x >> "` + calls + `"
+echo "token-$(wc -l < "` + calls + `" | tr -d ' ')"
+`
+	assert.NoError(t, os.WriteFile(helper, []byte(script), 0o700))
+
+	server, login := "https://example.atlassian.net", "user@example.com"
+
+	tok, err := credentialHelperToken(helper, server, login, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", tok.Value)
+	assert.Equal(t, TokenSourceHelper, tok.Source)
+
+	in, err := os.ReadFile(input)
+	assert.NoError(t, err)
+	assert.Equal(t, "protocol=https\nhost=example.atlassian.net\nusername=user@example.com\n\n", string(in))
+
+	// Token is cached for the process lifetime.
+	tok, err = credentialHelperToken(helper, server, login, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", tok.Value)
+
+	// Refresh skips the cache and updates it.
+	tok, err = credentialHelperToken(helper, server, login, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2", tok.Value)
+
+	tok, err = credentialHelperToken(helper, server, login, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2", tok.Value)
+
+	_, err = credentialHelperToken(filepath.Join(dir, "missing"), server, login, true)
+	assert.Error(t, err)
+}
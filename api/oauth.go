@@ -0,0 +1,186 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/keyring"
+	"github.com/ankitpokhrel/jira-cli/pkg/oauth"
+)
+
+var oauth2Sources = struct {
+	sync.Mutex
+	sources map[string]*oauth.TokenSource
+}{sources: make(map[string]*oauth.TokenSource)}
+
+// OAuth2Config returns the OAuth 2.0 client config. The client ID and secret
+// can be set with JIRA_OAUTH_CLIENT_ID and JIRA_OAUTH_CLIENT_SECRET env.
+func OAuth2Config() *oauth.Config {
+	get := func(env, key string) string {
+		if v := os.Getenv(env); v != "" {
+			return v
+		}
+		return viper.GetString(key)
+	}
+
+	port := oauth.DefaultCallbackPort
+	if p, err := strconv.Atoi(get("JIRA_OAUTH_CALLBACK_PORT", "oauth.callback_port")); err == nil {
+		port = p
+	}
+
+	return &oauth.Config{
+		ClientID:     get("JIRA_OAUTH_CLIENT_ID", "oauth.client_id"),
+		ClientSecret: get("JIRA_OAUTH_CLIENT_SECRET", "oauth.client_secret"),
+		Scopes:       viper.GetStringSlice("oauth.scopes"),
+		AuthURL:      viper.GetString("oauth.auth_url"),
+		TokenURL:     viper.GetString("oauth.token_url"),
+		APIURL:       viper.GetString("oauth.api_url"),
+		CallbackPort: port,
+	}
+}
+
+// OAuth2TokenSource returns the token source for the server.
+// The token is stored in the keyring and refreshed when it expires.
+func OAuth2TokenSource(server string) (*oauth.TokenSource, error) {
+	oauth2Sources.Lock()
+	defer oauth2Sources.Unlock()
+
+	key := oauth2KeyringUser(server)
+	if ts, ok := oauth2Sources.sources[key]; ok {
+		return ts, nil
+	}
+
+	kr, err := Keyring()
+	if err != nil {
+		return nil, err
+	}
+	ts := oauth.NewTokenSource(OAuth2Config(), &oauth2Store{kr: kr, user: key})
+	oauth2Sources.sources[key] = ts
+
+	return ts, nil
+}
+
+// SaveOAuth2Token stores the token obtained at login in the keyring.
+func SaveOAuth2Token(server string, tok *oauth.Token) (keyring.Backend, error) {
+	kr, err := Keyring()
+	if err != nil {
+		return "", err
+	}
+
+	oauth2Sources.Lock()
+	delete(oauth2Sources.sources, oauth2KeyringUser(server))
+	oauth2Sources.Unlock()
+
+	return (&oauth2Store{kr: kr, user: oauth2KeyringUser(server)}).save(tok)
+}
+
+// DeleteOAuth2Token removes the token from the keyring.
+func DeleteOAuth2Token(server string) error {
+	kr, err := Keyring()
+	if err != nil {
+		return err
+	}
+
+	oauth2Sources.Lock()
+	delete(oauth2Sources.sources, oauth2KeyringUser(server))
+	oauth2Sources.Unlock()
+
+	return kr.Delete(oauth2KeyringUser(server))
+}
+
+// oauth2ClientOptions returns the API gateway URL of the site, as the requests are sent through it
+// using the cloud ID of the site, and the options to authenticate them with the access token. If the
+// token can't be loaded, the requests fail with the reason instead of being sent unauthenticated.
+func oauth2ClientOptions(server string) (string, []jira.ClientFunc) {
+	ts, err := OAuth2TokenSource(server)
+	if err == nil {
+		var tok *oauth.Token
+		if tok, err = ts.Current(); err == nil {
+			if tok.CloudID != "" {
+				return OAuth2Config().APIBase(tok.CloudID), []jira.ClientFunc{
+					jira.WithTokenSource(ts.Token),
+					jira.WithTokenRefresher(ts.Refresh),
+				}
+			}
+			err = fmt.Errorf("no cloud ID stored for %s, run 'jira auth login' again", server)
+		}
+	}
+	err = oauth2Error(server, err)
+
+	return server, []jira.ClientFunc{jira.WithTokenSource(func() (string, error) {
+		return "", err
+	})}
+}
+
+func resolveOAuth2Token(server string) *Token {
+	ts, err := OAuth2TokenSource(server)
+	if err != nil {
+		return &Token{Source: TokenSourceNone, Err: err}
+	}
+	access, err := ts.Token()
+	if err != nil {
+		return &Token{Source: TokenSourceNone, Err: oauth2Error(server, err)}
+	}
+
+	t := Token{Value: access, Source: TokenSourceOAuth2}
+	if tok, err := ts.Current(); err == nil && !tok.Expiry.IsZero() {
+		expiry := tok.Expiry
+		t.Expiry = &expiry
+	}
+	return &t
+}
+
+// oauth2Error explains how to log in if no token is stored for the server.
+func oauth2Error(server string, err error) error {
+	if errors.Is(err, oauth.ErrNotLoggedIn) {
+		return fmt.Errorf("not logged in to %s, run 'jira auth login'", server)
+	}
+	return err
+}
+
+func oauth2KeyringUser(server string) string {
+	return "oauth2:" + strings.TrimSuffix(server, "/")
+}
+
+// oauth2Store stores the OAuth 2.0 token as JSON in the keyring.
+type oauth2Store struct {
+	kr   *keyring.Keyring
+	user string
+}
+
+func (s *oauth2Store) Load() (*oauth.Token, error) {
+	secret, _, err := s.kr.Get(s.user)
+	if err != nil {
+		if errors.Is(err, keyring.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var tok oauth.Token
+	if err := json.Unmarshal([]byte(secret), &tok); err != nil {
+		return nil, fmt.Errorf("invalid oauth token in the keyring: %w", err)
+	}
+	return &tok, nil
+}
+
+func (s *oauth2Store) Save(tok *oauth.Token) error {
+	_, err := s.save(tok)
+	return err
+}
+
+func (s *oauth2Store) save(tok *oauth.Token) (keyring.Backend, error) {
+	b, err := json.Marshal(tok)
+	if err != nil {
+		return "", err
+	}
+	return s.kr.Set(s.user, string(b))
+}
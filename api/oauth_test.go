@@ -0,0 +1,86 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/oauth"
+)
+
+func TestOAuth2Client(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("JIRA_KEYRING_BACKEND", "file")
+
+	var refreshed int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "refresh-0", r.PostForm.Get("refresh_token"))
+			refreshed++
+
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token":  "access-1",
+				"refresh_token": "refresh-1",
+				"expires_in":    3600,
+			})
+		case "/ex/jira/cloud-1/rest/api/2/myself":
+			assert.Equal(t, "Bearer access-1", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"displayName": "Person A", "emailAddress": "user@test.com"}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	viper.Reset()
+	jiraClient = nil
+	defer func() {
+		viper.Reset()
+		jiraClient = nil
+	}()
+
+	site := "https://example.atlassian.net"
+
+	viper.Set("server", site)
+	viper.Set("auth_type", "oauth2")
+	viper.Set("oauth.client_id", "client")
+	viper.Set("oauth.token_url", server.URL+"/oauth/token")
+	viper.Set("oauth.api_url", server.URL)
+
+	tok := ResolveToken(site, "")
+	assert.Equal(t, TokenSourceNone, tok.Source)
+	assert.EqualError(t, tok.Err, "not logged in to https://example.atlassian.net, run 'jira auth login'")
+
+	_, err := SaveOAuth2Token(site, &oauth.Token{
+		AccessToken:  "access-0",
+		RefreshToken: "refresh-0",
+		Expiry:       time.Now().Add(-time.Minute),
+		CloudID:      "cloud-1",
+	})
+	assert.NoError(t, err)
+
+	me, err := DefaultClient(false).Me()
+	assert.NoError(t, err)
+	assert.Equal(t, "Person A", me.Name)
+	assert.Equal(t, 1, refreshed)
+
+	// Refreshed token is reused until it expires.
+	tok = ResolveToken(site, "")
+	assert.Equal(t, TokenSourceOAuth2, tok.Source)
+	assert.Equal(t, "access-1", tok.Value)
+	assert.NotNil(t, tok.Expiry)
+	assert.Equal(t, 1, refreshed)
+
+	assert.NoError(t, DeleteOAuth2Token(site))
+	assert.Equal(t, TokenSourceNone, ResolveToken(site, "").Source)
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// PageFunc fetches a page of issues starting at the given offset.
+type PageFunc func(from, limit uint) (*jira.SearchResult, error)
+
+// Pager fetches the issues of a query page by page.
+//
+// The v2 search and the agile endpoints are paginated with the startAt offset
+// and return the total number of issues. The v3 search endpoint is paginated
+// with the token of the previous page and the total is approximated.
+type Pager struct {
+	fetch func(from uint, token string) (*jira.SearchResult, error)
+	count func() (int, error)
+	from  uint
+	token string
+	total int
+	more  bool
+
+	counted bool
+}
+
+// NewPager constructs a pager for an endpoint paginated with the startAt offset.
+func NewPager(fn PageFunc, from, limit uint) *Pager {
+	return &Pager{
+		fetch: func(from uint, _ string) (*jira.SearchResult, error) {
+			return fn(from, limit)
+		},
+		from: from,
+		more: true,
+	}
+}
+
+// NewSearchPager constructs a pager for the issues matching the JQL that uses either
+// a v2 or v3 version of the Jira GET /search endpoint based on configured installation type.
+// Defaults to v3 if installation type is not defined in the config.
+func NewSearchPager(c *jira.Client, jql string, from, limit uint) *Pager {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return NewPager(func(from, limit uint) (*jira.SearchResult, error) {
+			return c.SearchV2(jql, from, limit)
+		}, from, limit)
+	}
+
+	return &Pager{
+		fetch: func(_ uint, token string) (*jira.SearchResult, error) {
+			return c.SearchPage(jql, token, limit)
+		},
+		count: func() (int, error) {
+			return c.SearchCount(jql)
+		},
+		more: true,
+	}
+}
+
+// Next fetches the next page of issues.
+func (p *Pager) Next() ([]*jira.Issue, error) {
+	if !p.more {
+		return nil, nil
+	}
+
+	res, err := p.fetch(p.from, p.token)
+	if err != nil {
+		return nil, err
+	}
+
+	p.from += uint(len(res.Issues))
+	if p.count == nil {
+		p.total = res.Total
+		p.more = len(res.Issues) > 0 && int(p.from) < res.Total
+		return res.Issues, nil
+	}
+
+	p.token = res.NextPageToken
+	p.more = !res.IsLast && res.NextPageToken != ""
+
+	return res.Issues, nil
+}
+
+// More checks if there are more pages to fetch.
+func (p *Pager) More() bool {
+	return p.more
+}
+
+// Total returns the total number of issues, if known, and whether it is approximated.
+//
+// The approximate count is fetched once, on the first call, as it requires another request.
+func (p *Pager) Total() (int, bool) {
+	if p.count != nil && !p.counted {
+		p.counted = true
+		if n, err := p.count(); err == nil {
+			p.total = n
+		}
+	}
+	return p.total, p.count != nil
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestPager(t *testing.T) {
+	var calls []uint
+
+	p := NewPager(func(from, limit uint) (*jira.SearchResult, error) {
+		calls = append(calls, from)
+
+		res := &jira.SearchResult{Total: 5}
+		for i := from; i < min(from+limit, 5); i++ {
+			res.Issues = append(res.Issues, &jira.Issue{Key: fmt.Sprintf("TEST-%d", i+1)})
+		}
+		return res, nil
+	}, 0, 2)
+
+	var keys []string
+	for p.More() {
+		issues, err := p.Next()
+		assert.NoError(t, err)
+		for _, iss := range issues {
+			keys = append(keys, iss.Key)
+		}
+	}
+
+	assert.Equal(t, []uint{0, 2, 4}, calls)
+	assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4", "TEST-5"}, keys)
+
+	total, approx := p.Total()
+	assert.Equal(t, 5, total)
+	assert.False(t, approx)
+
+	issues, err := p.Next()
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestSearchPager(t *testing.T) {
+	var counted int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/search/approximate-count":
+			counted++
+			_, _ = w.Write([]byte(`{"count": 1340}`))
+		case "/rest/api/3/search/jql":
+			switch r.URL.Query().Get("nextPageToken") {
+			case "":
+				_, _ = w.Write([]byte(`{"isLast": false, "nextPageToken": "page-2", "issues": [{"key": "TEST-1"}]}`))
+			case "page-2":
+				_, _ = w.Write([]byte(`{"isLast": true, "issues": [{"key": "TEST-2"}]}`))
+			default:
+				t.Errorf("unexpected token %q", r.URL.Query().Get("nextPageToken"))
+			}
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+	p := NewSearchPager(client, "project=TEST", 0, 1)
+
+	issues, err := p.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-1", issues[0].Key)
+	assert.True(t, p.More())
+
+	total, approx := p.Total()
+	assert.Equal(t, 1340, total)
+	assert.True(t, approx)
+
+	issues, err = p.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-2", issues[0].Key)
+	assert.False(t, p.More())
+	assert.Equal(t, 1, counted)
+}
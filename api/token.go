@@ -0,0 +1,101 @@
+package api
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/keyring"
+	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
+)
+
+const (
+	keyringService = "jira-cli"
+	keyringFile    = ".credentials.json"
+)
+
+// TokenSource is a place the API token is resolved from.
+type TokenSource string
+
+const (
+	// TokenSourceNone means the token is not found.
+	TokenSourceNone TokenSource = "none"
+	// TokenSourceEnv is the JIRA_API_TOKEN env.
+	TokenSourceEnv TokenSource = "env"
+	// TokenSourceHelper is the credential helper command.
+	TokenSourceHelper TokenSource = "credential helper"
+	// TokenSourceConfig is the api_token key in the config.
+	TokenSourceConfig TokenSource = "config"
+	// TokenSourceNetrc is the .netrc file.
+	TokenSourceNetrc TokenSource = "netrc"
+	// TokenSourceKeyring is the system keyring.
+	TokenSourceKeyring TokenSource = "keyring"
+	// TokenSourceKeyringFile is the file based keyring fallback.
+	TokenSourceKeyringFile TokenSource = "keyring (file)"
+	// TokenSourceOAuth2 is the OAuth 2.0 access token stored in the keyring.
+	TokenSourceOAuth2 TokenSource = "oauth2"
+)
+
+// Token is a resolved API token.
+type Token struct {
+	Value  string
+	Source TokenSource
+	// Expiry is nil if the expiry is not known.
+	Expiry *time.Time
+	// Err is the reason the token could not be resolved, if any.
+	Err error
+}
+
+// ResolveToken resolves the API token for the login. The token is looked up in
+// JIRA_API_TOKEN env, credential helper, api_token config, .netrc file and the
+// keyring, in that order. Other sources are not consulted if the credential
+// helper is configured but fails. The OAuth 2.0 access token is the only
+// source if the auth type is oauth2.
+func ResolveToken(server, login string) *Token {
+	if jira.AuthType(viper.GetString("auth_type")) == jira.AuthTypeOAuth2 {
+		return resolveOAuth2Token(server)
+	}
+	if t := os.Getenv("JIRA_API_TOKEN"); t != "" {
+		return &Token{Value: t, Source: TokenSourceEnv}
+	}
+	if helper := CredentialHelper(); helper != "" {
+		t, err := credentialHelperToken(helper, server, login, false)
+		if err != nil {
+			return &Token{Source: TokenSourceNone, Err: err}
+		}
+		return t
+	}
+	if t := viper.GetString("api_token"); t != "" {
+		return &Token{Value: t, Source: TokenSourceConfig}
+	}
+	if netrcConfig, _ := netrc.Read(server, login); netrcConfig != nil && netrcConfig.Password != "" {
+		return &Token{Value: netrcConfig.Password, Source: TokenSourceNetrc}
+	}
+	if kr, err := Keyring(); err == nil {
+		if secret, backend, err := kr.Get(login); err == nil && secret != "" {
+			src := TokenSourceKeyring
+			if backend == keyring.BackendFile {
+				src = TokenSourceKeyringFile
+			}
+			return &Token{Value: secret, Source: src}
+		}
+	}
+	return &Token{Source: TokenSourceNone}
+}
+
+// Keyring returns the keyring used to store the API tokens. The file based
+// fallback is used if the system keyring is unavailable or if the backend
+// is forced with JIRA_KEYRING_BACKEND env.
+func Keyring() (*keyring.Keyring, error) {
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return nil, err
+	}
+	file := fmt.Sprintf("%s/%s/%s", home, cmdutil.ConfigDir, keyringFile)
+
+	return keyring.New(keyringService, file, keyring.Backend(os.Getenv("JIRA_KEYRING_BACKEND"))), nil
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveToken(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("NETRC", t.TempDir()+"/.netrc")
+	t.Setenv("JIRA_KEYRING_BACKEND", "file")
+	t.Setenv("JIRA_API_TOKEN", "")
+
+	viper.Reset()
+	defer viper.Reset()
+
+	assert.Equal(t, TokenSourceNone, ResolveToken("https://example.com", "user").Source)
+
+	kr, err := Keyring()
+	assert.NoError(t, err)
+	_, err = kr.Set("user", "from-keyring")
+	assert.NoError(t, err)
+
+	tok := ResolveToken("https://example.com", "user")
+	assert.Equal(t, TokenSourceKeyringFile, tok.Source)
+	assert.Equal(t, "from-keyring", tok.Value)
+
+	viper.Set("api_token", "from-config")
+	tok = ResolveToken("https://example.com", "user")
+	assert.Equal(t, TokenSourceConfig, tok.Source)
+	assert.Equal(t, "from-config", tok.Value)
+
+	t.Setenv("JIRA_API_TOKEN", "from-env")
+	tok = ResolveToken("https://example.com", "user")
+	assert.Equal(t, TokenSourceEnv, tok.Source)
+	assert.Equal(t, "from-env", tok.Value)
+}
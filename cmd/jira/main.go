@@ -9,6 +9,27 @@ import (
 
 func main() {
 	rootCmd := root.NewCmdRoot()
+
+	args, shell, err := root.ExpandAlias(rootCmd, os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		os.Exit(1)
+	}
+	if shell {
+		code, err := root.RunShellAlias(args)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		}
+		os.Exit(code)
+	}
+	if code, ok, err := root.RunExtension(rootCmd, args); ok {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		}
+		os.Exit(code)
+	}
+	rootCmd.SetArgs(args)
+
 	if _, err := rootCmd.ExecuteC(); err != nil {
 		fmt.Fprintf(os.Stderr, "%s\n", err)
 		os.Exit(1)
@@ -0,0 +1,39 @@
+package alias
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias/set"
+)
+
+const helpText = `Alias manages shortcuts for jira commands. See available commands below.
+
+Aliases are stored in the user config file under the "aliases" key and
+are expanded before the command is run, so 'jira NAME ARGS' runs the
+aliased command with the arguments. The builtin commands always take
+precedence over the aliases.`
+
+// NewCmdAlias is an alias command.
+func NewCmdAlias() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "alias",
+		Short:   "Alias manages shortcuts for jira commands",
+		Long:    helpText,
+		Aliases: []string{"aliases"},
+		RunE:    alias,
+	}
+
+	cmd.AddCommand(
+		set.NewCmdSet(),
+		list.NewCmdList(),
+		delete.NewCmdDelete(),
+	)
+
+	return &cmd
+}
+
+func alias(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,43 @@
+package delete
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// NewCmdDelete is a delete command.
+func NewCmdDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete NAME",
+		Short:   "Delete removes an alias",
+		Long:    "Delete removes an alias from the user config file.",
+		Example: "$ jira alias delete wip",
+		Aliases: []string{"remove", "rm"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the alias",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  del,
+	}
+}
+
+func del(_ *cobra.Command, args []string) {
+	name := args[0]
+
+	cmdutil.ExitIfError(cmdutil.Invalid(jiraConfig.ValidateAliasName(name)))
+
+	ok, err := jiraConfig.DeleteAlias(name)
+	cmdutil.ExitIfError(err)
+
+	if !ok {
+		cmdutil.FailedWithCode(cmdutil.ExitNotFound, "Alias %q not found", name)
+	}
+	if cmdutil.IsStructuredOutput() {
+		cmdcommon.PrintResult(cmdcommon.NewNamedResult(cmdcommon.ResultDeleted, name))
+		return
+	}
+	cmdutil.Success("Deleted alias %q", name)
+}
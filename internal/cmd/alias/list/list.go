@@ -0,0 +1,43 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List displays the aliases",
+		Long:    "List displays the aliases defined with 'jira alias set'.",
+		Aliases: []string{"lists", "ls"},
+		Run:     list,
+	}
+}
+
+func list(*cobra.Command, []string) {
+	aliases := jiraConfig.Aliases()
+
+	if cmdutil.IsStructuredOutput() {
+		cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), aliases))
+		return
+	}
+
+	if len(aliases) == 0 {
+		cmdutil.Failed("No aliases found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, name := range jiraConfig.AliasNames(aliases) {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", name, aliases[name])
+	}
+	_ = w.Flush()
+}
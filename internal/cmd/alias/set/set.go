@@ -0,0 +1,116 @@
+package set
+
+import (
+	"fmt"
+
+	shellquote "github.com/kballard/go-shellquote"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	helpText = `Set creates or updates an alias for a jira command.
+
+The expansion is a jira command without the 'jira' prefix. Positional
+parameters $1, $2, ... are replaced with the arguments given to the alias
+and the rest of the arguments are appended, so that more flags can be
+passed. Command substitutions $(...) are run with the shell each time
+the alias is used. Use single quotes to keep them from running when the
+alias is set.
+
+An expansion that starts with '!' is a shell alias. It is run with 'sh'
+and can use pipes, redirections and other shell features. The arguments
+are available as $1, $2, ... and $@ and are not appended.`
+	examples = `$ jira alias set wip 'issue list -s"In Progress" -a$(jira me)'
+
+# Use positional parameters
+$ jira alias set mv 'issue move $1 "$2"'
+$ jira mv ISSUE-1 "In Progress" --comment "Picked up"
+
+# Create a shell alias
+$ jira alias set todo '!jira issue list -s"To Do" --plain --no-headers | wc -l'
+$ jira alias set epic-issues --shell 'jira issue list -P"$1" --plain "$@"'`
+)
+
+// NewCmdSet is a set command.
+func NewCmdSet() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "set NAME EXPANSION",
+		Short:   "Set creates or updates an alias",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"add"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the alias\n" +
+				"EXPANSION\tCommand the alias expands to, starting with '!' for a shell alias",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  set,
+	}
+
+	cmd.Flags().BoolP("shell", "s", false, "Create a shell alias, same as starting the expansion with '!'")
+
+	return &cmd
+}
+
+func set(cmd *cobra.Command, args []string) {
+	name, expansion := args[0], args[1]
+
+	shell, err := cmd.Flags().GetBool("shell")
+	cmdutil.ExitIfError(err)
+
+	if shell && !jiraConfig.IsShellAlias(expansion) {
+		expansion = jiraConfig.ShellAliasPrefix + expansion
+	}
+
+	cmdutil.ExitIfError(validate(cmd, name, expansion))
+
+	_, exists := jiraConfig.Alias(name)
+
+	path, err := jiraConfig.SaveAlias(name, expansion)
+	cmdutil.ExitIfError(err)
+
+	if cmdutil.IsStructuredOutput() {
+		action := cmdcommon.ResultCreated
+		if exists {
+			action = cmdcommon.ResultUpdated
+		}
+		res := cmdcommon.NewNamedResult(action, name)
+		res.Value = expansion
+		res.Path = path
+		cmdcommon.PrintResult(res)
+		return
+	}
+
+	if exists {
+		cmdutil.Success("Changed alias %q in %s", name, path)
+	} else {
+		cmdutil.Success("Added alias %q to %s", name, path)
+	}
+}
+
+func validate(cmd *cobra.Command, name, expansion string) error {
+	if err := jiraConfig.ValidateAliasName(name); err != nil {
+		return cmdutil.Invalid(err)
+	}
+	if cmdcommon.IsBuiltinCommand(cmd, name) {
+		return cmdutil.Invalidf("%q is already a jira command", name)
+	}
+	if jiraConfig.IsShellAlias(expansion) {
+		return nil
+	}
+
+	words, err := shellquote.Split(expansion)
+	if err != nil {
+		return cmdutil.Invalid(fmt.Errorf("invalid expansion: %w", err))
+	}
+	if len(words) == 0 || !cmdcommon.IsBuiltinCommand(cmd, words[0]) {
+		return cmdutil.Invalidf(
+			"expansion must start with a jira command, eg: 'issue list', or with '!' for a shell alias",
+		)
+	}
+	return nil
+}
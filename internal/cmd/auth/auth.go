@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/login"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/logout"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/status"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/token"
+)
+
+const helpText = `Auth manages the API token used to authenticate with the Jira server.
+
+The token is resolved from JIRA_API_TOKEN env, credential_helper command,
+api_token config, .netrc file and the keyring, in that order. If the system keyring is not available, eg: on a
+headless Linux machine, a file with restricted permissions is used instead.
+Set JIRA_KEYRING_BACKEND env to 'file' to always use the file.
+
+If the auth type is oauth2, the OAuth 2.0 token stored in the keyring is used instead.`
+
+// NewCmdAuth is an auth command.
+func NewCmdAuth() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "auth",
+		Short: "Auth manages authentication with the Jira server",
+		Long:  helpText,
+		RunE:  auth,
+	}
+
+	cmd.AddCommand(
+		login.NewCmdLogin(),
+		logout.NewCmdLogout(),
+		status.NewCmdStatus(),
+		token.NewCmdToken(),
+	)
+
+	return &cmd
+}
+
+func auth(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,208 @@
+package login
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/browser"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/keyring"
+	"github.com/ankitpokhrel/jira-cli/pkg/oauth"
+)
+
+const (
+	helpText = `Login verifies the API token and stores it in the keyring.
+
+For cloud server, generate the API token from your Atlassian account.
+For local server, use your password for basic auth or a personal access token for bearer auth.
+If the login is not configured, the one the token is verified for is saved to the config.
+
+If the auth type is oauth2, login opens the browser to authorize the OAuth 2.0 app
+configured with oauth.client_id and stores the refresh token in the keyring instead.`
+	examples = `$ jira auth login
+
+# Read token from the standard input
+$ jira auth login --with-token < token.txt
+
+# Authorize the OAuth 2.0 app from a remote machine
+$ jira auth login --no-browser`
+
+	oauth2Timeout = 5 * time.Minute
+	verifyTimeout = 15 * time.Second
+)
+
+// NewCmdLogin is a login command.
+func NewCmdLogin() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "login",
+		Short:   "Login stores the API token in the keyring",
+		Long:    helpText,
+		Example: examples,
+		Run:     login,
+	}
+
+	cmd.Flags().Bool("with-token", false, "Read token from the standard input")
+	cmd.Flags().Bool("no-browser", false, "Print the OAuth 2.0 authorization link instead of opening the browser")
+
+	return &cmd
+}
+
+func login(cmd *cobra.Command, _ []string) {
+	server := viper.GetString("server")
+	user := viper.GetString("login")
+
+	if server == "" {
+		cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	if jira.AuthType(viper.GetString("auth_type")) == jira.AuthTypeOAuth2 {
+		noBrowser, err := cmd.Flags().GetBool("no-browser")
+		cmdutil.ExitIfError(err)
+
+		loginOAuth2(server, debug, noBrowser)
+		return
+	}
+
+	withToken, err := cmd.Flags().GetBool("with-token")
+	cmdutil.ExitIfError(err)
+
+	secret, err := readToken(withToken)
+	cmdutil.ExitIfError(err)
+
+	me, err := func() (*jira.Me, error) {
+		s := cmdutil.Info("Verifying token...")
+		defer s.Stop()
+
+		return api.Client(jira.Config{APIToken: secret, Debug: debug}).Me()
+	}()
+	cmdutil.ExitIfError(err)
+
+	// Bearer tokens are not tied to the configured login, so we save the verified one
+	// to store the token under the same login it is looked up with later on.
+	if user == "" {
+		user = cmp.Or(me.Login, me.Email)
+		if user != "" {
+			cmdutil.ExitIfError(jiraConfig.SaveLogin(user))
+		}
+	}
+
+	kr, err := api.Keyring()
+	cmdutil.ExitIfError(err)
+
+	backend, err := kr.Set(user, secret)
+	cmdutil.ExitIfError(err)
+
+	if backend == keyring.BackendFile {
+		cmdutil.Warn("System keyring is not available, the token is stored in a file with restricted permissions.")
+	}
+	loggedIn(server, me.Name)
+
+	if src := api.ResolveToken(server, user).Source; src != api.TokenSourceKeyring && src != api.TokenSourceKeyringFile {
+		cmdutil.Warn("\nThe token from %s takes precedence over the one stored in the keyring.", src)
+	}
+}
+
+func loginOAuth2(server string, debug, noBrowser bool) {
+	cfg := api.OAuth2Config()
+	if cfg.ClientID == "" {
+		cmdutil.Failed("Missing OAuth 2.0 client ID.\nSet oauth.client_id in the config or JIRA_OAUTH_CLIENT_ID env.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), oauth2Timeout)
+	defer cancel()
+
+	open := func(link string) error {
+		// Keep the stdout clean for the result if a structured output is requested.
+		out := os.Stdout
+		if cmdutil.IsStructuredOutput() {
+			out = os.Stderr
+		}
+		_, _ = fmt.Fprintf(out, "Open the following link to authorize jira-cli:\n\n%s\n\n", link)
+		if !noBrowser {
+			_ = browser.Browse(link)
+		}
+		return nil
+	}
+
+	tok, err := cfg.Authorize(ctx, open)
+	cmdutil.ExitIfError(err)
+
+	me, err := func() (*jira.Me, error) {
+		s := cmdutil.Info("Verifying access...")
+		defer s.Stop()
+
+		resources, err := cfg.Resources(ctx, tok.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		res, err := oauth.FindResource(resources, server)
+		if err != nil {
+			return nil, err
+		}
+		tok.CloudID = res.ID
+
+		authType := jira.AuthTypeOAuth2
+		client := jira.NewClient(
+			jira.Config{Server: cfg.APIBase(res.ID), APIToken: tok.AccessToken, AuthType: &authType, Debug: debug},
+			jira.WithTimeout(verifyTimeout),
+		)
+		return client.Me()
+	}()
+	cmdutil.ExitIfError(err)
+
+	backend, err := api.SaveOAuth2Token(server, tok)
+	cmdutil.ExitIfError(err)
+
+	if backend == keyring.BackendFile {
+		cmdutil.Warn("System keyring is not available, the token is stored in a file with restricted permissions.")
+	}
+	loggedIn(server, me.Name)
+}
+
+func loggedIn(server, name string) {
+	if cmdutil.IsStructuredOutput() {
+		cmdcommon.PrintResult(cmdcommon.NewAuthResult(cmdcommon.ResultLoggedIn, server, name))
+		return
+	}
+	cmdutil.Success("Logged in to %s as %s", server, name)
+}
+
+func readToken(fromStdin bool) (string, error) {
+	if fromStdin {
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", err
+		}
+		if t := strings.TrimSpace(string(b)); t != "" {
+			return t, nil
+		}
+		return "", fmt.Errorf("no token found in the standard input")
+	}
+
+	var secret string
+
+	qs := &survey.Password{
+		Message: "API token:",
+		Help:    "API token for cloud server, password or personal access token for local server",
+	}
+	if err := survey.AskOne(qs, &secret, survey.WithValidator(survey.Required)); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(secret), nil
+}
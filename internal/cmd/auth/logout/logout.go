@@ -0,0 +1,63 @@
+package logout
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/keyring"
+)
+
+// NewCmdLogout is a logout command.
+func NewCmdLogout() *cobra.Command {
+	return &cobra.Command{
+		Use:   "logout",
+		Short: "Logout removes the API token from the keyring",
+		Long:  "Logout removes the API token of the configured user from the keyring.",
+		Run:   logout,
+	}
+}
+
+func logout(*cobra.Command, []string) {
+	server := viper.GetString("server")
+	user := viper.GetString("login")
+
+	if jira.AuthType(viper.GetString("auth_type")) == jira.AuthTypeOAuth2 {
+		if err := api.DeleteOAuth2Token(server); err != nil {
+			if errors.Is(err, keyring.ErrNotFound) {
+				cmdutil.Failed("Not logged in to %s", server)
+			}
+			cmdutil.ExitIfError(err)
+		}
+		if cmdutil.IsStructuredOutput() {
+			cmdcommon.PrintResult(cmdcommon.NewAuthResult(cmdcommon.ResultLoggedOut, server, ""))
+			return
+		}
+		cmdutil.Success("Removed OAuth 2.0 token for %s from the keyring", server)
+		return
+	}
+
+	kr, err := api.Keyring()
+	cmdutil.ExitIfError(err)
+
+	if err := kr.Delete(user); err != nil {
+		if errors.Is(err, keyring.ErrNotFound) {
+			cmdutil.Failed("No token found in the keyring for %s", user)
+		}
+		cmdutil.ExitIfError(err)
+	}
+	if cmdutil.IsStructuredOutput() {
+		cmdcommon.PrintResult(cmdcommon.NewAuthResult(cmdcommon.ResultLoggedOut, server, user))
+	} else {
+		cmdutil.Success("Removed token for %s from the keyring", user)
+	}
+
+	if src := api.ResolveToken(server, user).Source; src != api.TokenSourceNone {
+		cmdutil.Warn("\nA token is still available from %s.", src)
+	}
+}
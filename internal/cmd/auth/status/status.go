@@ -0,0 +1,86 @@
+package status
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// NewCmdStatus is a status command.
+func NewCmdStatus() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Status displays the authentication status",
+		Long:  "Status verifies the token and displays where it came from along with the user and server details.",
+		Run:   status,
+	}
+}
+
+func status(cmd *cobra.Command, _ []string) {
+	server := viper.GetString("server")
+	login := viper.GetString("login")
+	authType := jira.AuthType(viper.GetString("auth_type"))
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	t := api.ResolveToken(server, login)
+	if t.Err != nil {
+		cmdutil.Failed("Unable to get the API token: %s", t.Err)
+	}
+	if t.Source == api.TokenSourceNone && authType != jira.AuthTypeMTLS {
+		cmdutil.Failed("Not logged in to %s.\nRun 'jira auth login' to store the token in the keyring.", server)
+	}
+
+	client := api.DefaultClient(debug)
+
+	var (
+		me   *jira.Me
+		info *jira.ServerInfo
+	)
+	err = func() error {
+		s := cmdutil.Info("Checking authentication status...")
+		defer s.Stop()
+
+		var err error
+		if me, err = client.Me(); err != nil {
+			return err
+		}
+		info, err = client.ServerInfo()
+		return err
+	}()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "Server:\t%s\n", server)
+	_, _ = fmt.Fprintf(w, "Login:\t%s\n", login)
+	_, _ = fmt.Fprintf(w, "Auth type:\t%s\n", authType)
+	_, _ = fmt.Fprintf(w, "Token source:\t%s\n", t.Source)
+	_, _ = fmt.Fprintf(w, "Token expiry:\t%s\n", formatExpiry(t.Expiry))
+	if me != nil {
+		_, _ = fmt.Fprintf(w, "User:\t%s\n", me.Name)
+	}
+	if info != nil {
+		_, _ = fmt.Fprintf(w, "Server version:\t%s (%s)\n", info.Version, info.DeploymentType)
+	}
+	_ = w.Flush()
+
+	cmdutil.ExitIfError(err)
+}
+
+func formatExpiry(expiry *time.Time) string {
+	if expiry == nil {
+		return "unknown"
+	}
+	if time.Now().After(*expiry) {
+		return fmt.Sprintf("expired on %s", expiry.Format(time.RFC1123))
+	}
+	return expiry.Format(time.RFC1123)
+}
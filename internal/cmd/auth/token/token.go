@@ -0,0 +1,38 @@
+package token
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const examples = `$ jira auth token
+
+# Use the token in a script
+$ curl -u "$(jira me):$(jira auth token)" https://company.atlassian.net/rest/api/2/myself`
+
+// NewCmdToken is a token command.
+func NewCmdToken() *cobra.Command {
+	return &cobra.Command{
+		Use:     "token",
+		Short:   "Token prints the resolved API token",
+		Long:    "Token prints the resolved API token to use in scripts.",
+		Example: examples,
+		Run:     token,
+	}
+}
+
+func token(*cobra.Command, []string) {
+	t := api.ResolveToken(viper.GetString("server"), viper.GetString("login"))
+	if t.Err != nil {
+		cmdutil.Failed("Unable to get the API token: %s", t.Err)
+	}
+	if t.Source == api.TokenSourceNone {
+		cmdutil.Failed("No token found.\nRun 'jira auth login' to store the token in the keyring.")
+	}
+	fmt.Println(t.Value)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -14,13 +15,17 @@ import (
 
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists boards in a project",
 		Long:    "List lists boards in a project.",
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmdcommon.SetOutputFlags(&cmd)
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -53,7 +58,10 @@ func List(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	v := view.NewBoard(boards)
+	output, err := cmdcommon.GetOutput(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	v := view.NewBoard(boards, view.WithBoardOutput(output))
 
 	cmdutil.ExitIfError(v.Render())
 }
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/config/edit"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/config/get"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/config/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/config/refresh"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/config/set"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/config/unset"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/config/validate"
+)
+
+const helpText = `Config manages jira-cli configuration. See available commands below.
+
+Commands that modify the configuration operate on the user config file.
+Use 'jira init' to generate the config file if it doesn't exist yet.`
+
+// NewCmdConfig is a config command.
+func NewCmdConfig() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "config",
+		Short: "Config manages jira-cli configuration",
+		Long:  helpText,
+		RunE:  config,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		get.NewCmdGet(),
+		set.NewCmdSet(),
+		unset.NewCmdUnset(),
+		edit.NewCmdEdit(),
+		validate.NewCmdValidate(),
+		refresh.NewCmdRefresh(),
+	)
+
+	return &cmd
+}
+
+func config(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,54 @@
+package edit
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/config/validate"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const helpText = `Edit opens the user config file in your editor and validates it afterwards.
+
+The editor is picked from JIRA_EDITOR, VISUAL or EDITOR env in that order.`
+
+// NewCmdEdit is an edit command.
+func NewCmdEdit() *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit",
+		Short: "Edit opens the user config file in your editor",
+		Long:  helpText,
+		Run:   edit,
+	}
+}
+
+func edit(*cobra.Command, []string) {
+	path, err := jiraConfig.DefaultFile()
+	cmdutil.ExitIfError(err)
+
+	if !jiraConfig.Exists(path) {
+		cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
+	}
+	cmdutil.ExitIfError(surveyext.EditFile(os.Getenv("JIRA_EDITOR"), path))
+
+	if cmdutil.IsStructuredOutput() {
+		problems, err := validate.Problems(path, false)
+		cmdutil.ExitIfError(err)
+
+		res := cmdcommon.NewFileResult(cmdcommon.ResultEdited, path)
+		for _, p := range problems {
+			res.Errors = append(res.Errors, p.String())
+		}
+		cmdcommon.PrintResult(res)
+		return
+	}
+
+	if !validate.File(path, false) {
+		os.Exit(cmdutil.ExitValidation)
+	}
+	cmdutil.Success("Configuration is valid: %s", path)
+}
@@ -0,0 +1,49 @@
+package get
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/config/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Get displays the effective value of a config key.`
+	examples = `$ jira config get project.key
+
+# Nested values are printed as JSON
+$ jira config get board`
+)
+
+// NewCmdGet is a get command.
+func NewCmdGet() *cobra.Command {
+	return &cobra.Command{
+		Use:     "get KEY",
+		Short:   "Get displays the value of a config key",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "KEY\tConfig key in dot notation, eg: project.key",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  get,
+	}
+}
+
+func get(_ *cobra.Command, args []string) {
+	key := args[0]
+	if !viper.IsSet(key) {
+		cmdutil.FailedWithCode(cmdutil.ExitNotFound, "Key %q is not set", key)
+	}
+	val := list.Format(key, viper.Get(key))
+
+	if cmdutil.IsStructuredOutput() {
+		cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), map[string]string{key: val}))
+		return
+	}
+	fmt.Println(val)
+}
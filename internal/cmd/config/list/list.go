@@ -0,0 +1,96 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `List displays the effective configuration.
+
+The effective configuration is the user config merged with the
+per-repository .jira.yml file, if any. Secrets are masked.`
+	examples = `$ jira config list
+
+# List only keys defined in the config files
+$ jira config list --keys`
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List displays the effective configuration",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Run:     list,
+	}
+
+	cmd.Flags().Bool("keys", false, "Display only the keys")
+
+	return &cmd
+}
+
+func list(cmd *cobra.Command, _ []string) {
+	keysOnly, _ := cmd.Flags().GetBool("keys")
+
+	// Flags and environment bound to viper are not part of the config files.
+	keys := make([]string, 0)
+	for _, k := range viper.AllKeys() {
+		if k == "config" || k == "debug" || k == "output" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	if cmdutil.IsStructuredOutput() {
+		if keysOnly {
+			cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), keys))
+			return
+		}
+		out := make(map[string]string, len(keys))
+		for _, k := range keys {
+			out[k] = Format(k, viper.Get(k))
+		}
+		cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), out))
+		return
+	}
+
+	for _, k := range keys {
+		if keysOnly {
+			fmt.Println(k)
+			continue
+		}
+		fmt.Printf("%s=%s\n", k, Format(k, viper.Get(k)))
+	}
+}
+
+// Format formats the config value for display.
+func Format(key string, val interface{}) string {
+	if (key == "api_token" || key == "oauth.client_secret") && val != "" {
+		return "********"
+	}
+	switch v := val.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	case []interface{}, []string, map[string]interface{}:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(b)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
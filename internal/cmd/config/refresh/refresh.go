@@ -0,0 +1,110 @@
+package refresh
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	helpText = `Refresh re-fetches the metadata cached in the config at init time.
+
+Issue types, custom fields and epic fields are discovered when running 'jira init'.
+Use this command to pick up changes made by your Jira admin without re-running init
+and losing the manual edits. Only the selected keys are updated. All of them are
+refreshed if no flag is passed.`
+	examples = `$ jira config refresh
+
+# Refresh only issue types
+$ jira config refresh --issue-types
+
+# See what would change without updating the config
+$ jira config refresh --fields --epic --dry-run`
+)
+
+// NewCmdRefresh is a refresh command.
+func NewCmdRefresh() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "refresh",
+		Short:   "Refresh re-fetches metadata cached in the config",
+		Long:    helpText,
+		Example: examples,
+		Run:     refresh,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().Bool("issue-types", false, "Refresh issue types")
+	cmd.Flags().Bool("fields", false, "Refresh custom fields")
+	cmd.Flags().Bool("epic", false, "Refresh epic name and link fields")
+	cmd.Flags().Bool("dry-run", false, "Display the changes without updating the config")
+
+	return &cmd
+}
+
+func refresh(cmd *cobra.Command, _ []string) {
+	issueTypes, err := cmd.Flags().GetBool("issue-types")
+	cmdutil.ExitIfError(err)
+
+	fields, err := cmd.Flags().GetBool("fields")
+	cmdutil.ExitIfError(err)
+
+	epic, err := cmd.Flags().GetBool("epic")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	path, err := jiraConfig.DefaultFile()
+	cmdutil.ExitIfError(err)
+
+	if !jiraConfig.Exists(path) {
+		cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
+	}
+
+	file, err := jiraConfig.Load(path)
+	cmdutil.ExitIfError(err)
+
+	gen := jiraConfig.NewJiraCLIConfigGenerator(&jiraConfig.JiraCLIConfig{})
+	changes, err := gen.Refresh(file, jiraConfig.RefreshOptions{
+		IssueTypes: issueTypes,
+		Fields:     fields,
+		Epic:       epic,
+	})
+	cmdutil.ExitIfError(err)
+
+	if cmdutil.IsStructuredOutput() {
+		// The config is not updated in a dry run, the changes are only reported.
+		res := cmdcommon.NewFileResult(cmdcommon.ResultUnchanged, file.Path())
+		for _, c := range changes {
+			res.Changes = append(res.Changes, c.String())
+		}
+		if len(changes) > 0 && !dryRun {
+			cmdutil.ExitIfError(file.Save())
+			res.Action = cmdcommon.ResultRefreshed
+		}
+		cmdcommon.PrintResult(res)
+		return
+	}
+
+	if len(changes) == 0 {
+		cmdutil.Success("Metadata is up to date")
+		return
+	}
+
+	fmt.Println()
+	for _, c := range changes {
+		fmt.Println(c)
+	}
+
+	if dryRun {
+		return
+	}
+
+	cmdutil.ExitIfError(file.Save())
+	cmdutil.Success("Updated %d metadata entries in %s", len(changes), file.Path())
+}
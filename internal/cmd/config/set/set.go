@@ -0,0 +1,78 @@
+package set
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	helpText = `Set updates the value of a config key in the user config file.
+
+The value is validated against the schema of known keys. List values
+are comma separated.`
+	examples = `$ jira config set project.key PRJ
+
+# Set a list value
+$ jira config set issue.defaults.labels backend,urgent
+
+# Set a key that is not known to the tool
+$ jira config set --force custom.key value`
+)
+
+// NewCmdSet is a set command.
+func NewCmdSet() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "set KEY VALUE",
+		Short:   "Set updates the value of a config key",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "KEY\tConfig key in dot notation, eg: project.key\nVALUE\tValue to set",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  set,
+	}
+
+	cmd.Flags().Bool("force", false, "Set the key even if it is not known to the tool")
+
+	return &cmd
+}
+
+func set(cmd *cobra.Command, args []string) {
+	key, val := args[0], args[1]
+
+	force, err := cmd.Flags().GetBool("force")
+	cmdutil.ExitIfError(err)
+
+	var value interface{} = val
+
+	if k, ok := jiraConfig.LookupKey(key); ok {
+		value, err = k.Parse(val)
+		if err != nil {
+			cmdutil.Failed("Invalid value for %q: %s", key, err)
+		}
+	} else if !force {
+		cmdutil.Failed("Unknown config key %q. Use --force to set it anyway.", key)
+	}
+
+	path, err := jiraConfig.DefaultFile()
+	cmdutil.ExitIfError(err)
+
+	file, err := jiraConfig.Load(path)
+	cmdutil.ExitIfError(err)
+
+	file.Set(key, value)
+	cmdutil.ExitIfError(file.Save())
+
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewNamedResult(cmdcommon.ResultSet, key)
+		res.Value = val
+		res.Path = file.Path()
+		cmdcommon.PrintResult(res)
+		return
+	}
+	cmdutil.Success("Updated %q in %s", key, file.Path())
+}
@@ -0,0 +1,47 @@
+package unset
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// NewCmdUnset is an unset command.
+func NewCmdUnset() *cobra.Command {
+	return &cobra.Command{
+		Use:     "unset KEY",
+		Short:   "Unset removes a key from the user config file",
+		Long:    "Unset removes a key from the user config file.",
+		Example: "$ jira config unset issue.defaults.labels",
+		Annotations: map[string]string{
+			"help:args": "KEY\tConfig key in dot notation, eg: project.key",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  unset,
+	}
+}
+
+func unset(_ *cobra.Command, args []string) {
+	key := args[0]
+
+	path, err := jiraConfig.DefaultFile()
+	cmdutil.ExitIfError(err)
+
+	file, err := jiraConfig.Load(path)
+	cmdutil.ExitIfError(err)
+
+	if !file.Unset(key) {
+		cmdutil.Failed("Key %q is not set in %s", key, file.Path())
+	}
+	cmdutil.ExitIfError(file.Save())
+
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewNamedResult(cmdcommon.ResultUnset, key)
+		res.Path = file.Path()
+		cmdcommon.PrintResult(res)
+		return
+	}
+	cmdutil.Success("Removed %q from %s", key, file.Path())
+}
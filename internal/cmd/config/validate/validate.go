@@ -0,0 +1,136 @@
+package validate
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const helpText = `Validate checks the config files against the schema of known keys.
+
+It reports unknown or misspelled keys, invalid enum values and file paths
+that do not exist. The per-repository .jira.yml file, if any, is validated
+as well. The command exits with status 2 if any problem is found.`
+
+// NewCmdValidate is a validate command.
+func NewCmdValidate() *cobra.Command {
+	return &cobra.Command{
+		Use:     "validate",
+		Short:   "Validate checks the config files for problems",
+		Long:    helpText,
+		Aliases: []string{"lint"},
+		Run:     validate,
+	}
+}
+
+// ProblemDetail is a problem found in the config file.
+type ProblemDetail struct {
+	Key     string `json:"key" yaml:"key"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// Report is a machine-readable result of the validation of a config file.
+type Report struct {
+	Path     string          `json:"path" yaml:"path"`
+	Valid    bool            `json:"valid" yaml:"valid"`
+	Error    string          `json:"error,omitempty" yaml:"error,omitempty"`
+	Problems []ProblemDetail `json:"problems" yaml:"problems"`
+}
+
+func validate(*cobra.Command, []string) {
+	path, err := jiraConfig.DefaultFile()
+	cmdutil.ExitIfError(err)
+
+	if !jiraConfig.Exists(path) {
+		cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
+	}
+
+	if cmdutil.IsStructuredOutput() {
+		reports := []*Report{NewReport(path, false)}
+		if local := localFile(); local != "" {
+			reports = append(reports, NewReport(local, true))
+		}
+		cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), reports))
+
+		for _, r := range reports {
+			if !r.Valid {
+				os.Exit(cmdutil.ExitValidation)
+			}
+		}
+		return
+	}
+
+	valid := File(path, false)
+	if local := localFile(); local != "" {
+		valid = File(local, true) && valid
+	}
+
+	if !valid {
+		os.Exit(cmdutil.ExitValidation)
+	}
+	cmdutil.Success("Configuration is valid")
+}
+
+// localFile returns the per-repository config file of the working directory, if any.
+func localFile() string {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	return jiraConfig.FindLocal(cwd)
+}
+
+// NewReport validates the given config file and returns the result.
+// Set local to true for a per-repository config file.
+func NewReport(path string, local bool) *Report {
+	r := Report{Path: path, Problems: make([]ProblemDetail, 0)}
+
+	problems, err := Problems(path, local)
+	if err != nil {
+		r.Error = err.Error()
+		return &r
+	}
+	for _, p := range problems {
+		r.Problems = append(r.Problems, ProblemDetail{Key: p.Key, Message: p.Msg})
+	}
+	r.Valid = len(problems) == 0
+
+	return &r
+}
+
+// File validates the given config file and prints the problems found.
+// Set local to true for a per-repository config file. It returns false
+// if the file is invalid.
+func File(path string, local bool) bool {
+	problems, err := Problems(path, local)
+	if err != nil {
+		cmdutil.Fail("%s: %s", path, err)
+		return false
+	}
+	if len(problems) == 0 {
+		return true
+	}
+
+	cmdutil.Fail("%s: found %d problem(s)", path, len(problems))
+	for _, p := range problems {
+		fmt.Fprintf(os.Stderr, "  - %s\n", p)
+	}
+	return false
+}
+
+// Problems loads the given config file and returns the problems found.
+// Set local to true for a per-repository config file.
+func Problems(path string, local bool) ([]jiraConfig.Problem, error) {
+	file, err := jiraConfig.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	if local {
+		return jiraConfig.ValidateLocal(path, file.Settings()), nil
+	}
+	return jiraConfig.Validate(file.Settings()), nil
+}
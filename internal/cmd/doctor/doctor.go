@@ -0,0 +1,169 @@
+package doctor
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/doctor"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const helpText = `Doctor diagnoses the configuration and the connection to the Jira server.
+
+It checks the config file, the proxy env vars, where the API token is resolved
+from, that the server is reachable, the TLS and client certificates, the clock
+skew, the authentication, the installation type and version, and that the
+configured project, board, epic fields and custom fields still exist.
+
+Each problem comes with a hint on how to fix it. The checks that depend on a
+failed check are skipped. The command exits with a non-zero status if any
+check fails.`
+
+// NewCmdDoctor is a doctor command.
+func NewCmdDoctor() *cobra.Command {
+	return &cobra.Command{
+		Use:     "doctor",
+		Short:   "Doctor diagnoses the configuration and the connection",
+		Long:    helpText,
+		Aliases: []string{"diagnose"},
+		Run:     run,
+	}
+}
+
+func run(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	report := func() *doctor.Report {
+		s := cmdutil.Info("Running checks...")
+		defer s.Stop()
+
+		return doctor.Run(config(debug))
+	}()
+
+	if cmdutil.IsStructuredOutput() {
+		cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), report))
+	} else {
+		render(report)
+	}
+
+	if !report.OK {
+		os.Exit(cmdutil.ExitError)
+	}
+}
+
+func config(debug bool) *doctor.Config {
+	file, _ := jiraConfig.DefaultFile()
+
+	cfg := doctor.Config{
+		File:         file,
+		Exists:       jiraConfig.Exists(file),
+		Server:       viper.GetString("server"),
+		Login:        viper.GetString("login"),
+		AuthType:     jira.AuthType(viper.GetString("auth_type")),
+		Installation: viper.GetString("installation"),
+		Insecure:     viper.GetBool("insecure"),
+		MTLS: jira.MTLSConfig{
+			CaCert:     viper.GetString("mtls.ca_cert"),
+			ClientCert: viper.GetString("mtls.client_cert"),
+			ClientKey:  viper.GetString("mtls.client_key"),
+		},
+		Project:  viper.GetString("project.key"),
+		BoardID:  viper.GetInt("board.id"),
+		EpicName: viper.GetString("epic.name"),
+		EpicLink: viper.GetString("epic.link"),
+		Version: [3]int{
+			viper.GetInt("version.major"),
+			viper.GetInt("version.minor"),
+			viper.GetInt("version.patch"),
+		},
+	}
+	if cfg.AuthType == "" {
+		cfg.AuthType = jira.AuthTypeBasic
+	}
+
+	if cfg.Exists {
+		cfg.Problems = problems(file, false)
+		if cwd, err := os.Getwd(); err == nil {
+			if local := jiraConfig.FindLocal(cwd); local != "" {
+				cfg.Problems = append(cfg.Problems, problems(local, true)...)
+			}
+		}
+	}
+
+	if cfg.AuthType != jira.AuthTypeMTLS {
+		t := api.ResolveToken(cfg.Server, cfg.Login)
+		cfg.TokenSource, cfg.TokenExpiry, cfg.TokenErr = string(t.Source), t.Expiry, t.Err
+	}
+
+	if fields, err := cmdcommon.GetConfiguredCustomFields(); err == nil {
+		cfg.CustomFields = fields
+	}
+	if cfg.Server != "" {
+		cfg.Client = api.DefaultClient(debug)
+	}
+
+	return &cfg
+}
+
+func problems(file string, local bool) []string {
+	f, err := jiraConfig.Load(file)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var found []jiraConfig.Problem
+	if local {
+		found = jiraConfig.ValidateLocal(file, f.Settings())
+	} else {
+		found = jiraConfig.Validate(f.Settings())
+	}
+
+	out := make([]string, 0, len(found))
+	for _, p := range found {
+		if local {
+			out = append(out, fmt.Sprintf("%s: %s", file, p))
+		} else {
+			out = append(out, p.String())
+		}
+	}
+	return out
+}
+
+func render(report *doctor.Report) {
+	icons := map[doctor.Status]string{
+		doctor.StatusOK:   "\u001B[0;32m✓\u001B[0m",
+		doctor.StatusWarn: "\u001B[0;33m!\u001B[0m",
+		doctor.StatusFail: "\u001B[0;31m✗\u001B[0m",
+		doctor.StatusSkip: "\u001B[0;90m-\u001B[0m",
+	}
+
+	var warnings int
+	for _, c := range report.Checks {
+		fmt.Printf("%s %-14s %s\n", icons[c.Status], c.Name, c.Message)
+		if c.Hint != "" {
+			fmt.Printf("  %-14s \u001B[0;90m%s\u001B[0m\n", "", c.Hint)
+		}
+		if c.Status == doctor.StatusWarn {
+			warnings++
+		}
+	}
+
+	switch failed := report.Failed(); {
+	case failed > 0:
+		fmt.Println()
+		cmdutil.Fail("%d check(s) failed, %d warning(s)", failed, warnings)
+	case warnings > 0:
+		fmt.Println()
+		cmdutil.Warn("All checks passed with %d warning(s)", warnings)
+	default:
+		cmdutil.Success("All checks passed")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -74,7 +75,8 @@ func add(cmd *cobra.Command, args []string) {
 
 	var (
 		failed strings.Builder
-		passed bool
+		errs   []string
+		passed []string
 	)
 
 	err := func() error {
@@ -82,7 +84,11 @@ func add(cmd *cobra.Command, args []string) {
 		defer s.Stop()
 
 		if projectType != jira.ProjectTypeNextGen {
-			return client.EpicIssuesAdd(params.epicKey, params.issues...)
+			if err := client.EpicIssuesAdd(params.epicKey, params.issues...); err != nil {
+				return err
+			}
+			passed = params.issues
+			return nil
 		}
 
 		// If the project is of the next-gen type, we need to set the parent property for each issue.
@@ -90,11 +96,12 @@ func add(cmd *cobra.Command, args []string) {
 		// in a loop. We will print failed requests with exit code 1 at the end if there are any.
 		for _, iss := range params.issues {
 			if err := client.Edit(iss, &jira.EditRequest{ParentIssueKey: params.epicKey, SkipNotify: params.skipNotify}); err != nil {
-				msg := fmt.Sprintf("\n  - %s: %s", iss, cmdutil.NormalizeJiraError(err.Error()))
-				failed.WriteString(msg)
+				e := fmt.Sprintf("%s: %s", iss, cmdutil.NormalizeJiraError(err.Error()))
+				failed.WriteString("\n  - " + e)
+				errs = append(errs, e)
 			} else {
 				// We will show success message if at-least one request reports success.
-				passed = true
+				passed = append(passed, iss)
 			}
 		}
 
@@ -104,13 +111,24 @@ func add(cmd *cobra.Command, args []string) {
 		return nil
 	}()
 
+	if cmdutil.IsStructuredOutput() {
+		if len(passed) == 0 {
+			cmdutil.ExitIfError(err)
+		}
+		res := cmdcommon.NewResult(cmdcommon.ResultAdded, server, params.epicKey)
+		res.Issues = passed
+		res.Errors = append(res.Errors, errs...)
+		cmdcommon.PrintResult(res)
+		return
+	}
+
 	msg := fmt.Sprintf("Issues added to the epic %s\n%s", params.epicKey, cmdutil.GenerateServerBrowseURL(server, params.epicKey))
 
 	if projectType != jira.ProjectTypeNextGen {
 		cmdutil.ExitIfError(err)
 		cmdutil.Success(msg)
 	} else {
-		if passed {
+		if len(passed) > 0 {
 			cmdutil.Success(msg)
 		}
 		cmdutil.ExitIfError(err)
@@ -93,7 +93,7 @@ func create(cmd *cobra.Command, _ []string) {
 	params.Reporter = cmdcommon.GetRelevantUser(client, project, params.Reporter)
 	params.Assignee = cmdcommon.GetRelevantUser(client, project, params.Assignee)
 
-	key, err := func() (string, error) {
+	resp, err := func() (*jira.CreateResponse, error) {
 		s := cmdutil.Info("Creating an epic...")
 		defer s.Stop()
 
@@ -122,18 +122,20 @@ func create(cmd *cobra.Command, _ []string) {
 			cr.WithCustomFields(configuredCustomFields)
 		}
 
-		resp, err := client.CreateV2(&cr)
-		if err != nil {
-			return "", err
-		}
-		return resp.Key, nil
+		return client.CreateV2(&cr)
 	}()
-
 	cmdutil.ExitIfError(err)
-	cmdutil.Success("Epic created\n%s", cmdutil.GenerateServerBrowseURL(server, key))
+
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewResult(cmdcommon.ResultCreated, server, resp.Key)
+		res.ID = resp.ID
+		cmdcommon.PrintResult(res)
+	} else {
+		cmdutil.Success("Epic created\n%s", cmdutil.GenerateServerBrowseURL(server, resp.Key))
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
-		err := cmdutil.Navigate(server, key)
+		err := cmdutil.Navigate(server, resp.Key)
 		cmdutil.ExitIfError(err)
 	}
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
@@ -79,6 +80,8 @@ func epicList(cmd *cobra.Command, args []string) {
 
 	client := api.DefaultClient(debug)
 
+	cmdcommon.ApplySavedQuery(cmd)
+
 	if len(args) == 0 {
 		epicExplorerView(cmd, cmd.Flags(), project, projectType, server, client)
 	} else {
@@ -91,34 +94,33 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 	err := flags.Set("type", "") // Unset issue type.
 	cmdutil.ExitIfError(err)
 
+	watch, err := cmdcommon.GetWatch(flags)
+	cmdutil.ExitIfError(err)
+
+	var pager *api.Pager
+
 	issues, err := func() ([]*jira.Issue, error) {
 		s := cmdutil.Info("Fetching epic issues...")
 		defer s.Stop()
 
-		q, err := query.NewIssue(project, flags)
+		q, err := query.NewIssue(project, flags, cmdcommon.IssueQueryOptions()...)
 		if err != nil {
 			return nil, err
 		}
-
-		var resp *jira.SearchResult
-
-		if projectType == jira.ProjectTypeNextGen {
-			q.Params().Parent = key
-			q.Params().IssueType = ""
-
-			resp, err = client.Search(q.Get(), q.Params().Limit)
-		} else {
-			resp, err = client.EpicIssues(key, q.Get(), q.Params().From, q.Params().Limit)
+		jql := q.Get()
+		if watch != nil {
+			watch.Pager = func() view.IssuePager { return epicIssuePager(client, q, key, jql, projectType) }
 		}
 
-		if err != nil {
-			return nil, err
-		}
-		return resp.Issues, nil
+		pager = epicIssuePager(client, q, key, jql, projectType)
+		return pager.Next()
 	}()
 	cmdutil.ExitIfError(err)
 
 	if len(issues) == 0 {
+		if cmdcommon.RenderEmptyList() {
+			return
+		}
 		fmt.Println()
 		cmdutil.Failed("No result found for given query in project %q", project)
 		return
@@ -145,10 +147,15 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 	columns, err := flags.GetString("columns")
 	cmdutil.ExitIfError(err)
 
+	output, err := cmdcommon.GetOutput(flags)
+	cmdutil.ExitIfError(err)
+
 	v := view.IssueList{
 		Project: project,
 		Server:  server,
 		Data:    issues,
+		Pager:   pager,
+		Watch:   watch,
 		Refresh: func() {
 			singleEpicView(flags, key, project, projectType, server, client)
 		},
@@ -167,6 +174,7 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
 			Timezone:   viper.GetString("timezone"),
+			Output:     output,
 		},
 	}
 
@@ -174,7 +182,7 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 }
 
 func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, projectType, server string, client *jira.Client) {
-	q, err := query.NewIssue(project, flags)
+	q, err := query.NewIssue(project, flags, cmdcommon.IssueQueryOptions()...)
 	cmdutil.ExitIfError(err)
 
 	epics, err := func() ([]*jira.Issue, error) {
@@ -190,6 +198,9 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 	cmdutil.ExitIfError(err)
 
 	if len(epics) == 0 {
+		if cmdcommon.RenderEmptyList() {
+			return
+		}
 		fmt.Println()
 		cmdutil.Failed("No result found for given query in project %q", project)
 		return
@@ -198,30 +209,21 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 	fixedColumns, err := flags.GetUint("fixed-columns")
 	cmdutil.ExitIfError(err)
 
+	output, err := cmdcommon.GetOutput(flags)
+	cmdutil.ExitIfError(err)
+
 	v := view.EpicList{
 		Project: project,
 		Server:  server,
 		Data:    epics,
-		Issues: func(key string) []*jira.Issue {
-			var resp *jira.SearchResult
-
-			if projectType == jira.ProjectTypeNextGen {
-				q.Params().Parent = key
-				q.Params().IssueType = ""
-
-				resp, err = client.Search(q.Get(), q.Params().Limit)
-			} else {
-				resp, err = client.EpicIssues(key, "", q.Params().From, q.Params().Limit)
-			}
-			if err != nil {
-				return []*jira.Issue{}
-			}
-			return resp.Issues
+		Issues: func(key string) view.IssuePager {
+			return epicIssuePager(client, q, key, "", projectType)
 		},
 		Display: view.DisplayFormat{
 			FixedColumns: fixedColumns,
 			TableStyle:   cmdutil.GetTUIStyleConfig(),
 			Timezone:     viper.GetString("timezone"),
+			Output:       output,
 		},
 	}
 
@@ -238,6 +240,20 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 	}
 }
 
+// epicIssuePager returns a pager for the issues of the epic. The issues of an epic
+// in a next-gen project are searched by the parent, the jql filters the issues otherwise.
+func epicIssuePager(client *jira.Client, q *query.Issue, key, jql, projectType string) *api.Pager {
+	if projectType == jira.ProjectTypeNextGen {
+		q.Params().Parent = key
+		q.Params().IssueType = ""
+
+		return api.NewSearchPager(client, q.Get(), q.Params().From, q.Params().Limit)
+	}
+	return api.NewPager(func(from, limit uint) (*jira.SearchResult, error) {
+		return client.EpicIssues(key, jql, from, limit)
+	}, q.Params().From, q.Params().Limit)
+}
+
 func setFlags(cmd *cobra.Command) {
 	list.SetFlags(cmd)
 	cmd.Flags().Bool("table", false, "Display epics in table view")
@@ -246,4 +262,5 @@ func setFlags(cmd *cobra.Command) {
 func hideFlags(cmd *cobra.Command) {
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("type"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("parent"))
+	cmdutil.ExitIfError(cmd.Flags().MarkHidden("epic"))
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -68,7 +69,8 @@ func remove(cmd *cobra.Command, args []string) {
 
 	var (
 		failed strings.Builder
-		passed bool
+		errs   []string
+		passed []string
 	)
 
 	err := func() error {
@@ -76,16 +78,21 @@ func remove(cmd *cobra.Command, args []string) {
 		defer s.Stop()
 
 		if projectType != jira.ProjectTypeNextGen {
-			return client.EpicIssuesRemove(params.issues...)
+			if err := client.EpicIssuesRemove(params.issues...); err != nil {
+				return err
+			}
+			passed = params.issues
+			return nil
 		}
 
 		for _, iss := range params.issues {
 			if err := client.Edit(iss, &jira.EditRequest{ParentIssueKey: jira.AssigneeNone, SkipNotify: params.skipNotify}); err != nil {
-				msg := fmt.Sprintf("\n  - %s: %s", iss, cmdutil.NormalizeJiraError(err.Error()))
-				failed.WriteString(msg)
+				e := fmt.Sprintf("%s: %s", iss, cmdutil.NormalizeJiraError(err.Error()))
+				failed.WriteString("\n  - " + e)
+				errs = append(errs, e)
 			} else {
 				// We will show success message if at-least one request reports success.
-				passed = true
+				passed = append(passed, iss)
 			}
 		}
 
@@ -95,13 +102,23 @@ func remove(cmd *cobra.Command, args []string) {
 		return nil
 	}()
 
+	if cmdutil.IsStructuredOutput() {
+		if len(passed) == 0 {
+			cmdutil.ExitIfError(err)
+		}
+		res := cmdcommon.NewIssuesResult(cmdcommon.ResultRemoved, passed)
+		res.Errors = append(res.Errors, errs...)
+		cmdcommon.PrintResult(res)
+		return
+	}
+
 	msg := "Epic unassigned from given issues"
 
 	if projectType != jira.ProjectTypeNextGen {
 		cmdutil.ExitIfError(err)
 		cmdutil.Success(msg)
 	} else {
-		if passed {
+		if len(passed) > 0 {
 			cmdutil.Success(msg)
 		}
 		cmdutil.ExitIfError(err)
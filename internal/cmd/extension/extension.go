@@ -0,0 +1,53 @@
+package extension
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/extension/install"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/extension/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/extension/remove"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/extension/upgrade"
+)
+
+const helpText = `Extension manages the commands that extend jira. See available commands below.
+
+An extension is an executable named jira-<name>. Running 'jira <name>' runs
+the extension installed in ~/.config/.jira/extensions or found on PATH, in
+that order, with the rest of the arguments. The builtin commands and the
+aliases take precedence over the extensions.
+
+The resolved config is passed to the extension with the env vars below.
+The API token itself is not passed, run 'jira auth token' to get it.
+
+  JIRA_SERVER        Jira server URL
+  JIRA_LOGIN         Login used to authenticate
+  JIRA_TOKEN_SOURCE  Where the API token is resolved from, eg: keyring
+  JIRA_PROJECT_KEY   Project key
+  JIRA_BOARD_ID      Board ID
+  JIRA_BOARD_NAME    Board name
+  JIRA_CONFIG_FILE   Config file in use
+  JIRA_EXECUTABLE    Path of the jira executable`
+
+// NewCmdExtension is an extension command.
+func NewCmdExtension() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "extension",
+		Short:   "Extension manages jira-<name> commands",
+		Long:    helpText,
+		Aliases: []string{"extensions", "ext"},
+		RunE:    extension,
+	}
+
+	cmd.AddCommand(
+		install.NewCmdInstall(),
+		list.NewCmdList(),
+		remove.NewCmdRemove(),
+		upgrade.NewCmdUpgrade(),
+	)
+
+	return &cmd
+}
+
+func extension(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,66 @@
+package install
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/extension"
+)
+
+const (
+	helpText = `Install clones a git repository with an extension.
+
+The repository name must start with 'jira-' and the repository must have an
+executable of the same name in its root, eg: a script or a prebuilt binary.`
+	examples = `$ jira extension install acme/jira-tempo
+
+# Install from any git URL or from a local repository
+$ jira extension install https://git.example.com/tools/jira-compliance.git
+$ jira extension install ./jira-dashboard`
+)
+
+// NewCmdInstall is an install command.
+func NewCmdInstall() *cobra.Command {
+	return &cobra.Command{
+		Use:     "install REPO",
+		Short:   "Install installs an extension from a git repository",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "REPO\tGit URL, local path or OWNER/REPO of a GitHub repository",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  install,
+	}
+}
+
+func install(cmd *cobra.Command, args []string) {
+	repo := args[0]
+
+	name, err := extension.RepoName(repo)
+	cmdutil.ExitIfError(cmdutil.Invalid(err))
+
+	if cmdcommon.IsBuiltinCommand(cmd, name) {
+		cmdutil.ExitIfError(cmdutil.Invalidf("%q is already a jira command", name))
+	}
+
+	dir, err := extension.DefaultDir()
+	cmdutil.ExitIfError(err)
+
+	ext, err := func() (*extension.Extension, error) {
+		s := cmdutil.Info("Installing extension...")
+		defer s.Stop()
+
+		return extension.NewManager(dir).Install(repo)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewNamedResult(cmdcommon.ResultInstalled, ext.Name)
+		res.Value = ext.Version
+		cmdcommon.PrintResult(res)
+		return
+	}
+	cmdutil.Success("Installed extension %q at %s, run it with 'jira %s'", ext.Name, ext.Version, ext.Name)
+}
@@ -0,0 +1,57 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/extension"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List displays the extensions",
+		Long:    "List displays the installed extensions and the jira-<name> executables found on PATH.",
+		Aliases: []string{"lists", "ls"},
+		Run:     list,
+	}
+}
+
+func list(*cobra.Command, []string) {
+	dir, err := extension.DefaultDir()
+	cmdutil.ExitIfError(err)
+
+	exts, err := extension.NewManager(dir).List()
+	cmdutil.ExitIfError(err)
+
+	if cmdutil.IsStructuredOutput() {
+		if exts == nil {
+			exts = []*extension.Extension{}
+		}
+		cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), exts))
+		return
+	}
+
+	if len(exts) == 0 {
+		cmdutil.Failed("No extensions found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, e := range exts {
+		source := e.Path
+		if e.Source == extension.SourceInstalled {
+			source = e.URL
+			if e.Version != "" {
+				source += "@" + e.Version
+			}
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", e.Name, e.Source, source)
+	}
+	_ = w.Flush()
+}
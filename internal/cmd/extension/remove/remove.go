@@ -0,0 +1,49 @@
+package remove
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/extension"
+)
+
+// NewCmdRemove is a remove command.
+func NewCmdRemove() *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove NAME",
+		Short:   "Remove uninstalls an extension",
+		Long:    "Remove uninstalls an extension installed with 'jira extension install'.",
+		Example: "$ jira extension remove tempo",
+		Aliases: []string{"rm", "uninstall"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the extension without the jira- prefix",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  remove,
+	}
+}
+
+func remove(_ *cobra.Command, args []string) {
+	name := args[0]
+
+	dir, err := extension.DefaultDir()
+	cmdutil.ExitIfError(err)
+
+	err = extension.NewManager(dir).Remove(name)
+	switch {
+	case errors.Is(err, extension.ErrNotFound):
+		cmdutil.FailedWithCode(cmdutil.ExitNotFound, "Extension %q not found", name)
+	case errors.Is(err, extension.ErrNotInstalled):
+		cmdutil.FailedWithCode(cmdutil.ExitValidation, "Extension %q is not installed with 'jira extension install', remove it from PATH instead", name)
+	}
+	cmdutil.ExitIfError(err)
+
+	if cmdutil.IsStructuredOutput() {
+		cmdcommon.PrintResult(cmdcommon.NewNamedResult(cmdcommon.ResultRemoved, name))
+		return
+	}
+	cmdutil.Success("Removed extension %q", name)
+}
@@ -0,0 +1,130 @@
+package upgrade
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/extension"
+)
+
+const examples = `$ jira extension upgrade tempo
+
+# Upgrade all installed extensions
+$ jira extension upgrade --all`
+
+// NewCmdUpgrade is an upgrade command.
+func NewCmdUpgrade() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "upgrade [NAME]",
+		Short:   "Upgrade pulls the latest version of an extension",
+		Long:    "Upgrade pulls the latest version of an extension installed with 'jira extension install'.",
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "[NAME]\tName of the extension without the jira- prefix",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  upgrade,
+	}
+
+	cmd.Flags().Bool("all", false, "Upgrade all installed extensions")
+
+	return &cmd
+}
+
+func upgrade(cmd *cobra.Command, args []string) {
+	all, err := cmd.Flags().GetBool("all")
+	cmdutil.ExitIfError(err)
+
+	if (len(args) == 0) == !all {
+		cmdutil.ExitIfError(cmdutil.Invalidf("specify either an extension name or --all"))
+	}
+
+	dir, err := extension.DefaultDir()
+	cmdutil.ExitIfError(err)
+
+	m := extension.NewManager(dir)
+
+	names := args
+	if all {
+		exts, err := m.List()
+		cmdutil.ExitIfError(err)
+
+		for _, e := range exts {
+			if e.Source == extension.SourceInstalled {
+				names = append(names, e.Name)
+			}
+		}
+		if len(names) == 0 {
+			cmdutil.Failed("No installed extensions found")
+		}
+	}
+
+	results := make([]*cmdcommon.Result, 0, len(names))
+	for _, name := range names {
+		res, err := upgradeOne(m, name)
+		if err != nil {
+			if !all {
+				exitWithError(name, err)
+			}
+			cmdutil.Warn("Unable to upgrade extension %q: %s", name, err)
+
+			res = cmdcommon.NewNamedResult(cmdcommon.ResultUnchanged, name)
+			res.AddError(err)
+		}
+		results = append(results, res)
+	}
+
+	if cmdutil.IsStructuredOutput() {
+		if all {
+			cmdcommon.PrintResults(results)
+		} else {
+			cmdcommon.PrintResult(results[0])
+		}
+		return
+	}
+	for _, res := range results {
+		if len(res.Errors) > 0 {
+			os.Exit(cmdutil.ExitPartialFailure)
+		}
+	}
+}
+
+func upgradeOne(m *extension.Manager, name string) (*cmdcommon.Result, error) {
+	s := cmdutil.Info("Upgrading extension " + name + "...")
+
+	ext, upgraded, err := m.Upgrade(name)
+	s.Stop()
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := cmdcommon.NewNamedResult(cmdcommon.ResultUnchanged, name)
+	res.Value = ext.Version
+	if upgraded {
+		res.Action = cmdcommon.ResultUpgraded
+	}
+
+	if !cmdutil.IsStructuredOutput() {
+		if upgraded {
+			cmdutil.Success("Upgraded extension %q to %s", name, ext.Version)
+		} else {
+			cmdutil.Success("Extension %q is already up to date", name)
+		}
+	}
+	return res, nil
+}
+
+func exitWithError(name string, err error) {
+	switch {
+	case errors.Is(err, extension.ErrNotFound):
+		cmdutil.FailedWithCode(cmdutil.ExitNotFound, "Extension %q not found", name)
+	case errors.Is(err, extension.ErrNotInstalled):
+		cmdutil.FailedWithCode(cmdutil.ExitValidation, "Extension %q is not installed with 'jira extension install'", name)
+	}
+	cmdutil.ExitIfError(err)
+}
@@ -1,6 +1,7 @@
 package init
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -14,15 +15,41 @@ import (
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
+const examples = `$ jira init
+
+# Generate config without any prompt, eg: in CI or dev containers
+$ jira init --non-interactive --installation cloud --server https://company.atlassian.net \
+  --login jon@domain.tld --project PRJ --board 42
+
+# Values can also come from env (JIRA_SERVER, JIRA_LOGIN, JIRA_PROJECT, etc.) or a seed file
+$ jira init --non-interactive --seed ./jira-seed.yml`
+
+const initHelpText = `Init initializes jira configuration required for the tool to work properly.
+
+Use --non-interactive to generate the config without any prompt. Values are read from
+flags, env variables or a seed file, in that order. The result is printed in JSON format.
+
+EXIT CODES (non-interactive mode)
+  2  Missing or invalid value
+  3  Config already exists, use --force to overwrite
+  4  Unable to verify login details
+  5  Unable to fetch server details
+  6  Project or board not found
+  7  Unable to fetch metadata`
+
 type initParams struct {
-	installation string
-	server       string
-	login        string
-	authType     string
-	project      string
-	board        string
-	force        bool
-	insecure     bool
+	installation   string
+	server         string
+	login          string
+	authType       string
+	project        string
+	board          string
+	mtlsCaCert     string
+	mtlsClientCert string
+	mtlsClientKey  string
+	force          bool
+	insecure       bool
+	nonInteractive bool
 }
 
 // NewCmdInit is an init command.
@@ -30,8 +57,9 @@ func NewCmdInit() *cobra.Command {
 	cmd := cobra.Command{
 		Use:     "init",
 		Short:   "Init initializes jira config",
-		Long:    "Init initializes jira configuration required for the tool to work properly.",
-		Aliases: []string{"initialize", "configure", "config", "setup"},
+		Long:    initHelpText,
+		Example: examples,
+		Aliases: []string{"initialize", "configure", "setup"},
 		Run:     initialize,
 	}
 
@@ -40,56 +68,82 @@ func NewCmdInit() *cobra.Command {
 	cmd.Flags().String("installation", "", "Is this a 'cloud' or 'local' jira installation?")
 	cmd.Flags().String("server", "", "Link to your jira server")
 	cmd.Flags().String("login", "", "Jira login username or email based on your setup")
-	cmd.Flags().String("auth-type", "", "Authentication type can be basic, bearer or mtls")
+	cmd.Flags().String("auth-type", "", "Authentication type can be basic, bearer, mtls or oauth2")
 	cmd.Flags().String("project", "", "Your default project key")
-	cmd.Flags().String("board", "", "Name of your default board in the project")
+	cmd.Flags().String("board", "", "Name or ID of your default board in the project")
+	cmd.Flags().String("mtls-ca-cert", "", "Path to the CA certificate for mtls auth type")
+	cmd.Flags().String("mtls-client-cert", "", "Path to the client certificate for mtls auth type")
+	cmd.Flags().String("mtls-client-key", "", "Path to the client key for mtls auth type")
 	cmd.Flags().Bool("force", false, "Forcefully override existing config if it exists")
 	cmd.Flags().Bool("insecure", false, `If set, the tool will skip TLS certificate verification.
 This can be useful if your server is using self-signed certificates.`)
+	cmd.Flags().Bool("non-interactive", false, "Generate config without prompts, fails if a required value is missing")
+	cmd.Flags().String("seed", "", "Path to a YAML or JSON file with the values to use")
 
 	return &cmd
 }
 
 func parseFlags(flags query.FlagParser) *initParams {
-	installation, err := flags.GetString("installation")
-	cmdutil.ExitIfError(err)
-
-	server, err := flags.GetString("server")
+	seedFile, err := flags.GetString("seed")
 	cmdutil.ExitIfError(err)
 
-	login, err := flags.GetString("login")
-	cmdutil.ExitIfError(err)
+	seed := viper.New()
+	if seedFile != "" {
+		seed.SetConfigFile(seedFile)
+		if err := seed.ReadInConfig(); err != nil {
+			cmdutil.Failed("Unable to read seed file: %s", err)
+		}
+	}
 
-	authType, err := flags.GetString("auth-type")
-	cmdutil.ExitIfError(err)
+	// Flags have the highest priority followed by env and the seed file.
+	get := func(flag, env, key string) string {
+		val, err := flags.GetString(flag)
+		cmdutil.ExitIfError(err)
 
-	// If auth type is not provided, check if it's set in env.
-	if authType == "" {
-		authType = os.Getenv("JIRA_AUTH_TYPE")
+		if val == "" {
+			val = os.Getenv(env)
+		}
+		if val == "" {
+			val = seed.GetString(key)
+		}
+		return val
 	}
-	authType = strings.ToLower(authType)
-
-	project, err := flags.GetString("project")
-	cmdutil.ExitIfError(err)
 
-	board, err := flags.GetString("board")
-	cmdutil.ExitIfError(err)
+	installation := get("installation", "JIRA_INSTALLATION", "installation")
+	server := get("server", "JIRA_SERVER", "server")
+	login := get("login", "JIRA_LOGIN", "login")
+	authType := strings.ToLower(get("auth-type", "JIRA_AUTH_TYPE", "auth_type"))
+	project := get("project", "JIRA_PROJECT", "project")
+	board := get("board", "JIRA_BOARD", "board")
+	mtlsCaCert := get("mtls-ca-cert", "JIRA_MTLS_CA_CERT", "mtls.ca_cert")
+	mtlsClientCert := get("mtls-client-cert", "JIRA_MTLS_CLIENT_CERT", "mtls.client_cert")
+	mtlsClientKey := get("mtls-client-key", "JIRA_MTLS_CLIENT_KEY", "mtls.client_key")
 
 	force, err := flags.GetBool("force")
 	cmdutil.ExitIfError(err)
 
 	insecure, err := flags.GetBool("insecure")
 	cmdutil.ExitIfError(err)
+	if !insecure {
+		insecure = seed.GetBool("insecure")
+	}
+
+	nonInteractive, err := flags.GetBool("non-interactive")
+	cmdutil.ExitIfError(err)
 
 	return &initParams{
-		installation: installation,
-		server:       server,
-		login:        login,
-		authType:     authType,
-		project:      project,
-		board:        board,
-		force:        force,
-		insecure:     insecure,
+		installation:   installation,
+		server:         server,
+		login:          login,
+		authType:       authType,
+		project:        project,
+		board:          board,
+		mtlsCaCert:     mtlsCaCert,
+		mtlsClientCert: mtlsClientCert,
+		mtlsClientKey:  mtlsClientKey,
+		force:          force,
+		insecure:       insecure,
+		nonInteractive: nonInteractive,
 	}
 }
 
@@ -98,17 +152,28 @@ func initialize(cmd *cobra.Command, _ []string) {
 
 	c := jiraConfig.NewJiraCLIConfigGenerator(
 		&jiraConfig.JiraCLIConfig{
-			Installation: strings.ToLower(params.installation),
-			Server:       params.server,
-			Login:        params.login,
-			AuthType:     params.authType,
-			Project:      params.project,
-			Board:        params.board,
-			Force:        params.force,
-			Insecure:     params.insecure,
+			Installation:   strings.ToLower(params.installation),
+			Server:         params.server,
+			Login:          params.login,
+			AuthType:       params.authType,
+			Project:        params.project,
+			Board:          params.board,
+			Force:          params.force,
+			Insecure:       params.insecure,
+			NonInteractive: params.nonInteractive,
+			MTLS: jiraConfig.JiraCLIMTLSConfig{
+				CaCert:     params.mtlsCaCert,
+				ClientCert: params.mtlsClientCert,
+				ClientKey:  params.mtlsClientKey,
+			},
 		},
 	)
 
+	if params.nonInteractive {
+		generateNonInteractive(c)
+		return
+	}
+
 	if params.insecure {
 		cmdutil.Warn(`You are using --insecure option. In this mode, the client will NOT verify
 server's certificate chain and host name in requests to the jira server.`)
@@ -137,3 +202,22 @@ server's certificate chain and host name in requests to the jira server.`)
 
 	cmdutil.Success("Configuration generated: %s", file)
 }
+
+// generateNonInteractive generates the config and reports the result
+// in JSON format along with a stable exit code for the failures.
+func generateNonInteractive(c *jiraConfig.JiraCLIConfigGenerator) {
+	file, err := c.Generate()
+	if err != nil {
+		initErr, ok := err.(*jiraConfig.InitError)
+		if !ok {
+			initErr = &jiraConfig.InitError{Code: "unknown", Message: err.Error()}
+		}
+		out, _ := json.Marshal(map[string]interface{}{"error": initErr})
+		fmt.Fprintln(os.Stderr, string(out))
+		os.Exit(initErr.ExitCode())
+	}
+
+	out, err := json.Marshal(map[string]string{"config": file})
+	cmdutil.ExitIfError(err)
+	fmt.Println(string(out))
+}
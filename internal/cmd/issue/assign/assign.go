@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -80,7 +81,7 @@ func assign(cmd *cobra.Command, args []string) {
 
 	u, err := ac.verifyAssignee()
 	if err != nil {
-		cmdutil.Failed("Error: %s", err.Error())
+		cmdutil.FailedWithCode(cmdutil.ExitValidation, "Error: %s", err.Error())
 		return
 	}
 
@@ -110,12 +111,21 @@ func assign(cmd *cobra.Command, args []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
+	server := viper.GetString("server")
+
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewResult(cmdcommon.ResultAssigned, server, ac.params.key)
+		res.Assignee = uname
+		cmdcommon.PrintResult(res)
+		return
+	}
+
 	if uname == "unassigned" {
 		cmdutil.Success("User unassigned from the issue %q", ac.params.key)
 	} else {
 		cmdutil.Success("User %q assigned to issue %q", uname, ac.params.key)
 	}
-	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(viper.GetString("server"), ac.params.key))
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, ac.params.key))
 }
 
 type assignParams struct {
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/adf"
@@ -72,7 +73,7 @@ func clone(cmd *cobra.Command, args []string) {
 
 	cp := cc.getActualCreateParams(project, issue)
 
-	clonedIssueKey, err := func() (string, error) {
+	cloned, err := func() (*jira.CreateResponse, error) {
 		s := cmdutil.Info(fmt.Sprintf("Cloning %s...", key))
 		defer s.Stop()
 
@@ -88,25 +89,27 @@ func clone(cmd *cobra.Command, args []string) {
 		}
 		cr.ForProjectType(projectType)
 
-		resp, err := api.ProxyCreate(client, &cr)
-		if err != nil {
-			return "", err
-		}
-		return resp.Key, nil
+		return api.ProxyCreate(client, &cr)
 	}()
 	cmdutil.ExitIfError(err)
 
-	cmdutil.Success("Issue cloned\n%s", cmdutil.GenerateServerBrowseURL(server, clonedIssueKey))
+	clonedIssueKey := cloned.Key
 
-	var wg sync.WaitGroup
+	if !cmdutil.IsStructuredOutput() {
+		cmdutil.Success("Issue cloned\n%s", cmdutil.GenerateServerBrowseURL(server, clonedIssueKey))
+	}
+
+	var (
+		wg                 sync.WaitGroup
+		linkErr, assignErr error
+	)
 	wg.Add(1)
 
 	go func() {
 		defer wg.Done()
 
 		if err := client.LinkIssue(key, clonedIssueKey, "Cloners"); err != nil {
-			fmt.Println()
-			cmdutil.Failed("Unable to link cloned issue")
+			linkErr = fmt.Errorf("unable to link cloned issue: %w", err)
 		}
 	}()
 
@@ -121,25 +124,42 @@ func clone(cmd *cobra.Command, args []string) {
 				Project: project,
 			})
 			if err != nil || len(user) == 0 {
-				fmt.Println()
-				cmdutil.Failed("Unable to find assignee")
+				assignErr = fmt.Errorf("unable to find assignee")
+				return
 			}
 			if err = api.ProxyAssignIssue(client, clonedIssueKey, user[0], jira.AssigneeDefault); err != nil {
-				fmt.Println()
-				cmdutil.Failed("Unable to set assignee: %s", err.Error())
+				assignErr = fmt.Errorf("unable to set assignee: %w", err)
 			}
 		}()
 	}
 
-	s := cmdutil.Info("Updating metadata...")
-	defer s.Stop()
+	func() {
+		s := cmdutil.Info("Updating metadata...")
+		defer s.Stop()
+
+		wg.Wait()
+	}()
+
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewResult(cmdcommon.ResultCloned, server, clonedIssueKey)
+		res.ID = cloned.ID
+		res.Link = &cmdcommon.ResultLink{Type: "Cloners", Inward: key, Outward: clonedIssueKey}
+		res.AddError(linkErr)
+		res.AddError(assignErr)
+		cmdcommon.PrintResult(res)
+	} else {
+		for _, err := range []error{linkErr, assignErr} {
+			if err != nil {
+				fmt.Println()
+				cmdutil.FailedWithCode(cmdutil.ExitPartialFailure, "Error: %s", err.Error())
+			}
+		}
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, clonedIssueKey)
-		cmdutil.Fail(err.Error())
+		cmdutil.ExitIfError(err)
 	}
-
-	wg.Wait()
 }
 
 type createParams struct {
@@ -109,8 +109,12 @@ func add(cmd *cobra.Command, args []string) {
 
 	server := viper.GetString("server")
 
-	cmdutil.Success("Comment added to issue %q", ac.params.issueKey)
-	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, ac.params.issueKey))
+	if cmdutil.IsStructuredOutput() {
+		cmdcommon.PrintResult(cmdcommon.NewResult(cmdcommon.ResultCommented, server, ac.params.issueKey))
+	} else {
+		cmdutil.Success("Comment added to issue %q", ac.params.issueKey)
+		fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, ac.params.issueKey))
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, ac.params.issueKey)
@@ -77,6 +77,8 @@ func create(cmd *cobra.Command, _ []string) {
 	installation := viper.GetString("installation")
 
 	params := parseFlags(cmd.Flags())
+	cmdcommon.ApplyIssueDefaults(params)
+
 	client := api.DefaultClient(params.Debug)
 	cc := createCmd{
 		client: client,
@@ -150,7 +152,13 @@ func create(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	cmdutil.Success("Issue created\n%s", cmdutil.GenerateServerBrowseURL(server, issue.Key))
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewResult(cmdcommon.ResultCreated, server, issue.Key)
+		res.ID = issue.ID
+		cmdcommon.PrintResult(res)
+	} else {
+		cmdutil.Success("Issue created\n%s", cmdutil.GenerateServerBrowseURL(server, issue.Key))
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, issue.Key)
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -60,6 +61,10 @@ func del(cmd *cobra.Command, args []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
+	if cmdutil.IsStructuredOutput() {
+		cmdcommon.PrintResult(cmdcommon.NewResult(cmdcommon.ResultDeleted, viper.GetString("server"), mc.params.key))
+		return
+	}
 	cmdutil.Success(fmt.Sprintf("Issue %q removed successfully", mc.params.key))
 }
 
@@ -168,9 +168,19 @@ func edit(cmd *cobra.Command, args []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
-	cmdutil.Success("Issue updated\n%s", cmdutil.GenerateServerBrowseURL(server, params.issueKey))
-
-	handleUserAssign(project, params.issueKey, params.assignee, client)
+	assignErr := handleUserAssign(project, params.issueKey, params.assignee, client)
+
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewResult(cmdcommon.ResultUpdated, server, params.issueKey)
+		res.ID = issue.ID
+		res.AddError(assignErr)
+		cmdcommon.PrintResult(res)
+	} else {
+		cmdutil.Success("Issue updated\n%s", cmdutil.GenerateServerBrowseURL(server, params.issueKey))
+		if assignErr != nil {
+			cmdutil.FailedWithCode(cmdutil.ExitPartialFailure, "%s", assignErr)
+		}
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, params.issueKey)
@@ -224,26 +234,27 @@ func getAnswers(params *editParams, issue *jira.Issue) {
 	}
 }
 
-func handleUserAssign(project, key, assignee string, client *jira.Client) {
+func handleUserAssign(project, key, assignee string, client *jira.Client) error {
 	if assignee == "" {
-		return
+		return nil
 	}
 	if assignee == "x" {
 		if err := api.ProxyAssignIssue(client, key, nil, jira.AssigneeNone); err != nil {
-			cmdutil.Failed("Unable to unassign user: %s", err.Error())
+			return fmt.Errorf("unable to unassign user: %s", err.Error())
 		}
-		return
+		return nil
 	}
 	user, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
 		Query:   assignee,
 		Project: project,
 	})
 	if err != nil || len(user) == 0 {
-		cmdutil.Failed("Unable to find assignee")
+		return fmt.Errorf("unable to find assignee")
 	}
 	if err = api.ProxyAssignIssue(client, key, user[0], assignee); err != nil {
-		cmdutil.Failed("Unable to set assignee: %s", err.Error())
+		return fmt.Errorf("unable to set assignee: %s", err.Error())
 	}
+	return nil
 }
 
 type editCmd struct {
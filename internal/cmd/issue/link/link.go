@@ -11,6 +11,7 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link/remote"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -67,7 +68,7 @@ func link(cmd *cobra.Command, args []string) {
 	lt, err := lc.verifyIssueLinkType()
 	if err != nil {
 		fmt.Println()
-		cmdutil.Failed("Error: %s", err.Error())
+		cmdutil.FailedWithCode(cmdutil.ExitValidation, "Error: %s", err.Error())
 		return
 	}
 
@@ -81,8 +82,18 @@ func link(cmd *cobra.Command, args []string) {
 
 	server := viper.GetString("server")
 
-	cmdutil.Success("Issues linked as %q", lc.params.linkType)
-	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, lc.params.inwardIssueKey))
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewResult(cmdcommon.ResultLinked, server, lc.params.inwardIssueKey)
+		res.Link = &cmdcommon.ResultLink{
+			Type:    lt.Name,
+			Inward:  lc.params.inwardIssueKey,
+			Outward: lc.params.outwardIssueKey,
+		}
+		cmdcommon.PrintResult(res)
+	} else {
+		cmdutil.Success("Issues linked as %q", lc.params.linkType)
+		fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, lc.params.inwardIssueKey))
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, lc.params.inwardIssueKey)
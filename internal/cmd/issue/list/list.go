@@ -9,10 +9,12 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
 )
 
 const (
@@ -56,14 +58,39 @@ $ jira issue list --plain --delimiter "|"
 # List issues as raw JSON data
 $ jira issue list --raw
 
+# List some fields of the issues as JSON
+$ jira issue list --json key,fields.summary,fields.assignee.displayName
+
+# Format issues using a template
+$ jira issue list --template '{{range .}}{{.key | color "green"}} {{.fields.summary}} ({{date .fields.created "Jan 2"}}){{"\n"}}{{end}}'
+
 # List issues of type "Epic" in status "Done"
 $ jira issue list -tEpic -sDone
 
 # List issues in status other than "Open" and is assigned to no one
 $ jira issue list -s~Open -ax
 
+# List high priority bugs in the current sprint that are due this week
+$ jira issue list -tBug -yHigh --sprint current --due week
+
+# List issues assigned to or reported by me, ordered by priority and then by rank
+$ jira issue list -a$(jira me) -r$(jira me) --any assignee,reporter --order-by priority:desc,rank:asc
+
+# Search for the text in summary and description only
+$ jira issue list --text "login" --text-fields summary,description
+
+# Filter issues by custom fields configured in the config
+$ jira issue list --custom story-points=3 --epic EPIC-1
+
 # List issues from all projects
-$ jira issue list -q"project IS NOT EMPTY"`
+$ jira issue list -q"project IS NOT EMPTY"
+
+# Save a query and run it later, see 'jira jql'
+$ jira issue list -q"assignee = currentUser() AND status != Done" --save-query mine
+$ jira issue list --query mine
+
+# Refresh the list every 30 seconds, highlight the issues that changed and ring the terminal bell
+$ jira issue list -s"In Review" --watch=30s --notify bell`
 )
 
 // NewCmdList is a list command.
@@ -98,36 +125,51 @@ func loadList(cmd *cobra.Command, args []string) {
 	err = cmd.Flags().Set("parent", cmdutil.GetJiraIssueKey(project, pk))
 	cmdutil.ExitIfError(err)
 
+	cmdcommon.ApplySavedQuery(cmd)
+
 	if len(args) > 0 {
 		searchQuery := fmt.Sprintf(`text ~ %q`, strings.Join(args, " "))
 
 		jqlFlag, err := cmd.Flags().GetString("jql")
 		cmdutil.ExitIfError(err)
-		if jqlFlag != "" {
-			searchQuery = fmt.Sprintf(`%s AND %s`, jqlFlag, searchQuery)
+		q, err := jql.Merge(jqlFlag, searchQuery)
+		if err != nil {
+			cmdutil.ExitIfError(cmdutil.Invalidf("invalid query: %s", err))
 		}
-		cmdutil.ExitIfError(cmd.Flags().Set("jql", searchQuery))
+		cmdutil.ExitIfError(cmd.Flags().Set("jql", q))
 	}
 
+	watch, err := cmdcommon.GetWatch(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	var pager *api.Pager
+
 	issues, err := func() ([]*jira.Issue, error) {
 		s := cmdutil.Info("Fetching issues...")
 		defer s.Stop()
 
-		q, err := query.NewIssue(project, cmd.Flags())
+		q, err := query.NewIssue(project, cmd.Flags(), cmdcommon.IssueQueryOptions()...)
 		if err != nil {
 			return nil, err
 		}
 
-		resp, err := api.ProxySearch(api.DefaultClient(debug), q.Get(), q.Params().From, q.Params().Limit)
-		if err != nil {
-			return nil, err
+		client, jql := api.DefaultClient(debug), q.Get()
+		newPager := func() *api.Pager {
+			return api.NewSearchPager(client, jql, q.Params().From, q.Params().Limit)
+		}
+		if watch != nil {
+			watch.Pager = func() view.IssuePager { return newPager() }
 		}
 
-		return resp.Issues, nil
+		pager = newPager()
+		return pager.Next()
 	}()
 	cmdutil.ExitIfError(err)
 
 	if len(issues) == 0 {
+		if cmdcommon.RenderEmptyList() {
+			return
+		}
 		fmt.Println()
 		cmdutil.Failed("No result found for given query in project %q", project)
 		return
@@ -170,10 +212,17 @@ func loadList(cmd *cobra.Command, args []string) {
 		comments = max(numComments, 1)
 	}
 
+	output, err := cmdcommon.GetOutput(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	layout, saveLayout := cmdcommon.TableLayout("issue_list")
+
 	v := view.IssueList{
 		Project: project,
 		Server:  server,
 		Data:    issues,
+		Pager:   pager,
+		Watch:   watch,
 		Refresh: func() {
 			loadList(cmd, args)
 		},
@@ -192,7 +241,10 @@ func loadList(cmd *cobra.Command, args []string) {
 				return []string{}
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
+			Layout:     layout,
+			SaveLayout: saveLayout,
 			Timezone:   viper.GetString("timezone"),
+			Output:     output,
 		},
 	}
 
@@ -235,8 +287,27 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().String("updated-after", "", "Filter by issues updated after certain date")
 	cmd.Flags().String("created-before", "", "Filter by issues created before certain date")
 	cmd.Flags().String("updated-before", "", "Filter by issues updated before certain date")
+	cmd.Flags().String("due", "", "Filter issues by due date\n"+
+		"Accepts: overdue, today, week, month, year, a date in yyyy-mm-dd and yyyy/mm/dd format,\n"+
+		"or a period format using w = weeks, d = days. eg: 7d for issues due in the next 7 days\n"+
+		"Due filter can be combined with due-before filter to narrow down the result")
+	cmd.Flags().String("due-before", "", "Filter by issues due before certain date")
+	cmd.Flags().String("sprint", "", "Filter issues by sprint\n"+
+		"Accepts: current, open, future, closed, sprint ID or name")
+	cmd.Flags().String("fix-version", "", "Filter issues by fix version")
+	cmd.Flags().String("affects-version", "", "Filter issues by affected version")
+	cmd.Flags().String("epic", "", "Filter issues by epic key")
+	cmd.Flags().String("team", "", "Filter issues by team")
+	cmd.Flags().StringToString("custom", map[string]string{}, "Filter issues by custom fields configured in the config, eg: --custom story-points=3")
+	cmd.Flags().String("text", "", "Search for the text in the issues")
+	cmd.Flags().String("text-fields", "", "Comma separated list of fields to search the text in, eg: summary,description\n"+
+		"Searches all text fields by default")
+	cmd.Flags().String("any", "", "Comma separated list of filters to combine with OR instead of AND, eg: assignee,reporter\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(query.AnyGroups, ", ")))
 	cmd.Flags().StringP("jql", "q", "", "Run a raw JQL query in a given project context")
-	cmd.Flags().String("order-by", "created", "Field to order the list with")
+	cmdcommon.SetQueryFlags(cmd)
+	cmd.Flags().String("order-by", "created", "Field to order the list with\n"+
+		"Use comma separated field:direction pairs to order by multiple fields, eg: priority:desc,created")
 	cmd.Flags().Bool("reverse", false, "Reverse the display order (default \"DESC\")")
 	cmd.Flags().String("paginate", "0:100", "Paginate the result. Max 100 at a time, format: <from>:<limit> where <from> is optional")
 	cmd.Flags().Bool("plain", false, "Display output in plain mode")
@@ -246,6 +317,8 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().Uint("comments", 1, "Show N comments when viewing the issue")
 	cmd.Flags().Bool("raw", false, "Print raw JSON output")
 	cmd.Flags().Bool("csv", false, "Print output in CSV format")
+	cmdcommon.SetOutputFlags(cmd)
+	cmdcommon.SetWatchFlags(cmd)
 
 	if cmd.HasParent() && cmd.Parent().Name() != "sprint" {
 		cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode.\n"+
@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -71,7 +72,7 @@ func move(cmd *cobra.Command, args []string) {
 	tr, err := mc.verifyTransition(installation)
 	if err != nil {
 		fmt.Println()
-		cmdutil.Failed("Error: %s", err.Error())
+		cmdutil.FailedWithCode(cmdutil.ExitValidation, "Error: %s", err.Error())
 		return
 	}
 
@@ -118,8 +119,18 @@ func move(cmd *cobra.Command, args []string) {
 
 	server := viper.GetString("server")
 
-	cmdutil.Success("Issue transitioned to state %q", tr.Name)
-	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, mc.params.key))
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewResult(cmdcommon.ResultMoved, server, mc.params.key)
+		res.Status = tr.Name
+		if tr.To != nil && tr.To.Name != "" {
+			res.Status = tr.To.Name
+		}
+		res.Assignee = mc.params.assignee
+		cmdcommon.PrintResult(res)
+	} else {
+		cmdutil.Success("Issue transitioned to state %q", tr.Name)
+		fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, mc.params.key))
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, mc.params.key)
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -80,8 +81,17 @@ func watch(cmd *cobra.Command, args []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
+	server := viper.GetString("server")
+
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewResult(cmdcommon.ResultWatched, server, ac.params.key)
+		res.User = uname
+		cmdcommon.PrintResult(res)
+		return
+	}
+
 	cmdutil.Success("User %q added as watcher of issue %q", uname, ac.params.key)
-	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(viper.GetString("server"), ac.params.key))
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, ac.params.key))
 }
 
 type watchParams struct {
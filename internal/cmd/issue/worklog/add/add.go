@@ -107,6 +107,10 @@ func add(cmd *cobra.Command, args []string) {
 
 	server := viper.GetString("server")
 
+	if cmdutil.IsStructuredOutput() {
+		cmdcommon.PrintResult(cmdcommon.NewResult(cmdcommon.ResultLogged, server, ac.params.issueKey))
+		return
+	}
 	cmdutil.Success("Worklog added to issue %q", ac.params.issueKey)
 	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, ac.params.issueKey))
 }
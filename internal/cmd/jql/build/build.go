@@ -0,0 +1,360 @@
+package build
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql/validate"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Build helps to write a JQL query step by step.
+
+It asks for a field, an operator and a value for each clause. Fields,
+operators and functions are fetched from the Jira server, and values can
+be autocompleted by pressing tab. The query is validated once done and
+can be saved for reuse with 'jira issue list --query NAME'.`
+	examples = `$ jira jql build
+
+# Build and save the query
+$ jira jql build --save mine`
+
+	optionAnd     = "AND"
+	optionOr      = "OR"
+	optionOrderBy = "ORDER BY"
+	optionDone    = "Done"
+
+	maxSuggestions = 15
+)
+
+// NewCmdBuild is a build command.
+func NewCmdBuild() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "build",
+		Short:   "Build helps to write a JQL query interactively",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"new"},
+		Run:     build,
+	}
+
+	cmd.Flags().String("save", "", "Save the query under the given name")
+
+	return &cmd
+}
+
+func build(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	save, err := cmd.Flags().GetString("save")
+	cmdutil.ExitIfError(err)
+
+	if save != "" {
+		cmdutil.ExitIfError(cmdutil.Invalid(jiraConfig.ValidateQueryName(save)))
+	}
+
+	client := api.DefaultClient(debug)
+
+	data, err := func() (*jira.JQLAutocompleteData, error) {
+		s := cmdutil.Info("Fetching fields and functions. Please wait...")
+		defer s.Stop()
+
+		return client.JQLAutocompleteData()
+	}()
+	cmdutil.ExitIfError(err)
+
+	bc := buildCmd{client: client, data: data}
+
+	q, err := bc.ask()
+	cmdutil.ExitIfError(err)
+
+	echo("\n%s\n", q)
+
+	vr, err := validate.Query(client, q)
+	cmdutil.ExitIfError(err)
+
+	// The validation report is printed in place of the result only if the query is invalid.
+	if !vr.IsValid() || !cmdutil.IsStructuredOutput() {
+		if !validate.Print(vr) {
+			os.Exit(cmdutil.ExitValidation)
+		}
+	}
+
+	res := cmdcommon.NewNamedResult(cmdcommon.ResultCreated, save)
+	res.Value = q
+
+	if save == "" {
+		save, err = askName()
+		cmdutil.ExitIfError(err)
+	}
+	if save == "" {
+		if cmdutil.IsStructuredOutput() {
+			cmdcommon.PrintResult(res)
+		}
+		return
+	}
+
+	path, err := jiraConfig.SaveQuery(save, q)
+	cmdutil.ExitIfError(err)
+
+	if cmdutil.IsStructuredOutput() {
+		res.Name, res.Path = save, path
+		cmdcommon.PrintResult(res)
+		return
+	}
+	cmdutil.Success("Saved query %q to %s\nRun it with: jira issue list --query %s", save, path, save)
+}
+
+type buildCmd struct {
+	client *jira.Client
+	data   *jira.JQLAutocompleteData
+}
+
+func (bc *buildCmd) ask() (string, error) {
+	var (
+		b    strings.Builder
+		next = optionAnd
+	)
+
+	for next == optionAnd || next == optionOr {
+		clause, err := bc.askClause()
+		if err != nil {
+			return "", err
+		}
+		if b.Len() > 0 {
+			b.WriteString(" " + next + " ")
+		}
+		b.WriteString(clause)
+
+		echo("  %s\n", b.String())
+
+		if err := survey.AskOne(&survey.Select{
+			Message: "Next:",
+			Options: []string{optionAnd, optionOr, optionOrderBy, optionDone},
+		}, &next); err != nil {
+			return "", err
+		}
+	}
+
+	if next == optionOrderBy {
+		order, err := bc.askOrderBy()
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(" ORDER BY " + order)
+	}
+
+	return b.String(), nil
+}
+
+func (bc *buildCmd) askClause() (string, error) {
+	field, err := bc.askField("Field:", jira.JQLField.IsSearchable)
+	if err != nil {
+		return "", err
+	}
+
+	var op string
+	if err := survey.AskOne(&survey.Select{
+		Message: "Operator:",
+		Options: field.Operators,
+	}, &op); err != nil {
+		return "", err
+	}
+
+	list := op == "in" || op == "not in" || op == "was in" || op == "was not in"
+
+	msg := "Value:"
+	if list {
+		msg = "Values (comma separated):"
+	}
+
+	var val string
+	if err := survey.AskOne(&survey.Input{
+		Message: msg,
+		Suggest: bc.suggest(field, op, list),
+	}, &val, survey.WithValidator(survey.Required)); err != nil {
+		return "", err
+	}
+
+	if list {
+		values := make([]string, 0)
+		for _, v := range strings.Split(val, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, quote(v))
+			}
+		}
+		val = "(" + strings.Join(values, ", ") + ")"
+	} else {
+		val = quote(strings.TrimSpace(val))
+	}
+
+	return fmt.Sprintf("%s %s %s", fieldName(field), op, val), nil
+}
+
+func (bc *buildCmd) askOrderBy() (string, error) {
+	fields := make([]string, 0)
+
+	for {
+		field, err := bc.askField("Order by:", jira.JQLField.IsOrderable)
+		if err != nil {
+			return "", err
+		}
+
+		var dir string
+		if err := survey.AskOne(&survey.Select{
+			Message: "Direction:",
+			Options: []string{"DESC", "ASC"},
+		}, &dir); err != nil {
+			return "", err
+		}
+		fields = append(fields, fieldName(field)+" "+dir)
+
+		more := false
+		if err := survey.AskOne(&survey.Confirm{Message: "Order by another field?"}, &more); err != nil {
+			return "", err
+		}
+		if !more {
+			return strings.Join(fields, ", "), nil
+		}
+	}
+}
+
+func (bc *buildCmd) askField(msg string, filter func(jira.JQLField) bool) (*jira.JQLField, error) {
+	var (
+		options = make([]string, 0, len(bc.data.Fields))
+		fields  = make(map[string]*jira.JQLField, len(bc.data.Fields))
+	)
+	for i, f := range bc.data.Fields {
+		if !filter(f) {
+			continue
+		}
+		// Custom fields are listed as "Story Points - cf[10016]".
+		if _, ok := fields[f.DisplayName]; ok {
+			continue
+		}
+		options = append(options, f.DisplayName)
+		fields[f.DisplayName] = &bc.data.Fields[i]
+	}
+	sort.Strings(options)
+
+	var ans string
+	if err := survey.AskOne(&survey.Select{
+		Message:  msg,
+		Options:  options,
+		PageSize: maxSuggestions,
+	}, &ans); err != nil {
+		return nil, err
+	}
+	return fields[ans], nil
+}
+
+// suggest returns a function that suggests values for the field. For list operators,
+// only the value after the last comma is completed.
+func (bc *buildCmd) suggest(field *jira.JQLField, op string, list bool) func(string) []string {
+	return func(toComplete string) []string {
+		var prefix string
+		if list {
+			if i := strings.LastIndex(toComplete, ","); i >= 0 {
+				prefix, toComplete = toComplete[:i+1]+" ", strings.TrimSpace(toComplete[i+1:])
+			}
+		}
+
+		out := make([]string, 0)
+		if op == "is" || op == "is not" {
+			out = append(out, "EMPTY")
+		} else if field.HasSuggestions() {
+			// Suggestions are optional, the value can still be typed in.
+			if res, err := bc.client.JQLSuggestions(field.Value, toComplete); err == nil {
+				for _, s := range res {
+					out = append(out, s.Value)
+				}
+			}
+		}
+		for _, fn := range bc.data.Functions {
+			if fn.IsList == "true" && !list {
+				continue
+			}
+			if sharesType(fn.Types, field.Types) && strings.HasPrefix(strings.ToLower(fn.Value), strings.ToLower(toComplete)) {
+				out = append(out, fn.Value)
+			}
+		}
+
+		if len(out) > maxSuggestions {
+			out = out[:maxSuggestions]
+		}
+		for i := range out {
+			out[i] = prefix + out[i]
+		}
+		return out
+	}
+}
+
+// echo prints the query being built. It is printed to the stderr
+// if a structured output is requested to keep the stdout parsable.
+func echo(format string, args ...interface{}) {
+	out := os.Stdout
+	if cmdutil.IsStructuredOutput() {
+		out = os.Stderr
+	}
+	_, _ = fmt.Fprintf(out, format, args...)
+}
+
+func sharesType(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func askName() (string, error) {
+	var name string
+	err := survey.AskOne(&survey.Input{
+		Message: "Save as (leave empty to skip):",
+	}, &name, survey.WithValidator(func(ans interface{}) error {
+		if s, _ := ans.(string); s != "" {
+			return jiraConfig.ValidateQueryName(s)
+		}
+		return nil
+	}))
+	return strings.TrimSpace(name), err
+}
+
+// fieldName returns the name of the field to use in the query.
+// Custom fields are referred by their id, since names can be ambiguous.
+func fieldName(f *jira.JQLField) string {
+	if f.CFID != "" {
+		return f.CFID
+	}
+	return f.Value
+}
+
+// quote quotes the value unless it is already quoted, a function,
+// a reserved word or a single word that doesn't need quoting.
+func quote(v string) string {
+	if v == "" || strings.HasPrefix(v, `"`) || strings.HasPrefix(v, `'`) || strings.HasSuffix(v, ")") {
+		return v
+	}
+	switch strings.ToUpper(v) {
+	case "EMPTY", "NULL":
+		return strings.ToUpper(v)
+	}
+	if strings.ContainsAny(v, " \t\"'(),=!<>~&|*%+^$#@[]{};:/\\") {
+		return fmt.Sprintf("%q", v)
+	}
+	return v
+}
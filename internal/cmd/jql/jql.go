@@ -0,0 +1,39 @@
+package jql
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql/build"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql/remove"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql/validate"
+)
+
+const helpText = `JQL helps to write, validate and save JQL queries. See available commands below.
+
+Saved queries are stored in the user config file under the "queries" key
+and can be run with 'jira issue list --query NAME'.`
+
+// NewCmdJQL is a jql command.
+func NewCmdJQL() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "jql",
+		Short:       "JQL helps to write, validate and save JQL queries",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        jql,
+	}
+
+	cmd.AddCommand(
+		build.NewCmdBuild(),
+		validate.NewCmdValidate(),
+		list.NewCmdList(),
+		remove.NewCmdRemove(),
+	)
+
+	return &cmd
+}
+
+func jql(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,48 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List displays saved queries",
+		Long:    "List displays queries saved with 'jira jql build', 'jira jql validate' or 'jira issue list'.",
+		Aliases: []string{"lists", "ls"},
+		Run:     list,
+	}
+}
+
+func list(*cobra.Command, []string) {
+	names := jiraConfig.Queries()
+
+	if cmdutil.IsStructuredOutput() {
+		out := make(map[string]string, len(names))
+		for _, name := range names {
+			out[name], _ = jiraConfig.Query(name)
+		}
+		cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), out))
+		return
+	}
+
+	if len(names) == 0 {
+		cmdutil.Failed("No saved queries found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, name := range names {
+		q, _ := jiraConfig.Query(name)
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", name, q)
+	}
+	_ = w.Flush()
+}
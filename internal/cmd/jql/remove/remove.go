@@ -0,0 +1,43 @@
+package remove
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// NewCmdRemove is a remove command.
+func NewCmdRemove() *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove NAME",
+		Short:   "Remove deletes a saved query",
+		Long:    "Remove deletes a saved query from the user config file.",
+		Example: "$ jira jql remove mine",
+		Aliases: []string{"rm", "delete"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the saved query",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  remove,
+	}
+}
+
+func remove(_ *cobra.Command, args []string) {
+	name := args[0]
+
+	cmdutil.ExitIfError(cmdutil.Invalid(jiraConfig.ValidateQueryName(name)))
+
+	ok, err := jiraConfig.DeleteQuery(name)
+	cmdutil.ExitIfError(err)
+
+	if !ok {
+		cmdutil.FailedWithCode(cmdutil.ExitNotFound, "Query %q not found", name)
+	}
+	if cmdutil.IsStructuredOutput() {
+		cmdcommon.PrintResult(cmdcommon.NewNamedResult(cmdcommon.ResultRemoved, name))
+		return
+	}
+	cmdutil.Success("Removed query %q", name)
+}
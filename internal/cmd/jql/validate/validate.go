@@ -0,0 +1,164 @@
+package validate
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
+)
+
+const (
+	helpText = `Validate checks the JQL query against the Jira server.
+
+Errors point at the part of the query that failed to parse, or at the
+field, value or function Jira doesn't recognize. The command exits with
+a non-zero status if the query is invalid.`
+	examples = `$ jira jql validate 'project = TEST AND status = "In Progress"'
+
+# Validate a saved query
+$ jira jql validate --query mine
+
+# Save the query if it is valid
+$ jira jql validate 'assignee = currentUser() AND resolution IS EMPTY' --save mine`
+)
+
+// NewCmdValidate is a validate command.
+func NewCmdValidate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "validate [JQL]",
+		Short:   "Validate checks the JQL query for errors",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"check"},
+		Annotations: map[string]string{
+			"help:args": "[JQL]\tJQL query to validate",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  validate,
+	}
+
+	cmd.Flags().String("query", "", "Validate a saved query")
+	cmd.Flags().String("save", "", "Save the query under the given name if it is valid")
+
+	return &cmd
+}
+
+// ErrorDetail is an error found in the query.
+type ErrorDetail struct {
+	Message string `json:"message" yaml:"message"`
+	Offset  *int   `json:"offset,omitempty" yaml:"offset,omitempty"`
+	Length  int    `json:"length,omitempty" yaml:"length,omitempty"`
+}
+
+// Report is a machine-readable result of the validation.
+type Report struct {
+	Query  string        `json:"query" yaml:"query"`
+	Valid  bool          `json:"valid" yaml:"valid"`
+	Errors []ErrorDetail `json:"errors" yaml:"errors"`
+}
+
+func validate(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	name, err := cmd.Flags().GetString("query")
+	cmdutil.ExitIfError(err)
+
+	save, err := cmd.Flags().GetString("save")
+	cmdutil.ExitIfError(err)
+
+	if save != "" {
+		cmdutil.ExitIfError(cmdutil.Invalid(jiraConfig.ValidateQueryName(save)))
+	}
+
+	var q string
+	switch {
+	case len(args) > 0 && name != "":
+		cmdutil.FailedWithCode(cmdutil.ExitValidation, "Pass either a query or the --query flag, not both")
+	case len(args) > 0:
+		q = args[0]
+	case name != "":
+		saved, ok := jiraConfig.Query(name)
+		if !ok {
+			cmdutil.FailedWithCode(cmdutil.ExitValidation, "Query %q not found, see 'jira jql list'", name)
+		}
+		q = saved
+	default:
+		cmdutil.FailedWithCode(cmdutil.ExitValidation, "Nothing to validate, pass the query as an argument")
+	}
+
+	res, err := Query(api.DefaultClient(debug), q)
+	cmdutil.ExitIfError(err)
+
+	if !Print(res) {
+		os.Exit(cmdutil.ExitValidation)
+	}
+
+	if save != "" {
+		path, err := jiraConfig.SaveQuery(save, q)
+		cmdutil.ExitIfError(err)
+
+		if !cmdutil.IsStructuredOutput() {
+			cmdutil.Success("Saved query %q to %s", save, path)
+		}
+	}
+}
+
+// Query validates the query with a spinner.
+func Query(client *jira.Client, q string) (*jira.JQLParseResult, error) {
+	s := cmdutil.Info("Validating query...")
+	defer s.Stop()
+
+	return api.ProxyJQLValidate(client, q)
+}
+
+// Print prints the result of the validation and returns false if the query is invalid.
+// Errors are highlighted in the query where possible.
+func Print(res *jira.JQLParseResult) bool {
+	if cmdutil.IsStructuredOutput() {
+		cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), NewReport(res)))
+		return res.IsValid()
+	}
+
+	if res.IsValid() {
+		cmdutil.Success("Query is valid")
+		return true
+	}
+
+	for _, msg := range res.Errors {
+		cmdutil.Fail("%s", msg)
+		if pos, ok := jql.Locate(res.Query, msg); ok {
+			fmt.Fprintf(os.Stderr, "\n%s\n\n", indent(jql.Highlight(res.Query, pos)))
+		}
+	}
+	return false
+}
+
+// NewReport creates a report from the result of the validation.
+func NewReport(res *jira.JQLParseResult) *Report {
+	r := Report{
+		Query:  res.Query,
+		Valid:  res.IsValid(),
+		Errors: make([]ErrorDetail, 0, len(res.Errors)),
+	}
+	for _, msg := range res.Errors {
+		e := ErrorDetail{Message: msg}
+		if pos, ok := jql.Locate(res.Query, msg); ok {
+			offset := pos.Offset
+			e.Offset, e.Length = &offset, pos.Length
+		}
+		r.Errors = append(r.Errors, e)
+	}
+	return &r
+}
+
+func indent(s string) string {
+	return "  " + strings.ReplaceAll(s, "\n", "\n  ")
+}
@@ -2,9 +2,12 @@ package me
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 )
 
 // NewCmdMe is a me command.
@@ -18,5 +21,12 @@ func NewCmdMe() *cobra.Command {
 }
 
 func me(*cobra.Command, []string) {
+	if cmdutil.IsStructuredOutput() {
+		cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), map[string]string{
+			"login":  viper.GetString("login"),
+			"server": viper.GetString("server"),
+		}))
+		return
+	}
 	fmt.Println(viper.GetString("login"))
 }
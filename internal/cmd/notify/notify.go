@@ -0,0 +1,97 @@
+package notify
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Notify polls a JQL query on an interval and prints the issues that were added to,
+changed in or removed from the query since the previous poll as newline delimited JSON.
+
+The first poll is used as the baseline and prints nothing. An issue is changed when its
+update time changes, the fields shown in the lists that changed, if any, are included.
+Errors are reported to stderr and the query is polled again on the next interval.`
+
+	examples = `$ jira notify --jql "project = TEST AND status = 'In Review'"
+
+# Poll every 30 seconds and print the keys of the issues assigned to me that changed
+$ jira notify --jql "assignee = currentUser()" --interval 30s | jq -r 'select(.event == "changed") | .key'`
+
+	defaultInterval = "1m"
+	defaultLimit    = 100
+)
+
+// NewCmdNotify is a notify command.
+func NewCmdNotify() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "notify",
+		Short:   "Notify prints the changes of the issues matching a query",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     notify,
+	}
+
+	cmd.Flags().StringP("jql", "q", "", "JQL query to poll")
+	cmd.Flags().String("interval", defaultInterval, "Interval to poll the query on, eg: 30s or 5m")
+	cmd.Flags().Uint("limit", defaultLimit, "Maximum number of issues to poll")
+
+	_ = cmd.MarkFlagRequired("jql")
+
+	return &cmd
+}
+
+func notify(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	jql, err := cmd.Flags().GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	interval, err := cmd.Flags().GetString("interval")
+	cmdutil.ExitIfError(err)
+
+	d, err := cmdcommon.ParseWatchInterval(interval)
+	cmdutil.ExitIfError(err)
+
+	limit, err := cmd.Flags().GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+	poll := func() ([]*jira.Issue, error) {
+		res, err := api.ProxySearch(client, jql, 0, limit)
+		if err != nil {
+			return nil, err
+		}
+		return res.Issues, nil
+	}
+
+	prev, err := poll()
+	cmdutil.ExitIfError(err)
+
+	enc := json.NewEncoder(os.Stdout)
+	ticker := time.NewTicker(d)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		next, err := poll()
+		if err != nil {
+			cmdutil.Warn("Unable to poll the query: %s", err)
+			continue
+		}
+		for _, ev := range view.IssueEvents(prev, next, now) {
+			cmdutil.ExitIfError(enc.Encode(ev))
+		}
+		prev = next
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -11,13 +12,17 @@ import (
 
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists Jira projects",
 		Long:    "List lists Jira projects that a user has access to.",
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmdcommon.SetOutputFlags(&cmd)
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -42,7 +47,10 @@ func List(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	v := view.NewProject(projects)
+	output, err := cmdcommon.GetOutput(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	v := view.NewProject(projects, view.WithProjectOutput(output))
 
 	cmdutil.ExitIfError(v.Render())
 }
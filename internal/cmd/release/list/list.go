@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -12,13 +13,17 @@ import (
 
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists Jira projects versions",
 		Long:    "List lists Jira projects versions that a user has access to.",
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmdcommon.SetOutputFlags(&cmd)
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -44,7 +49,10 @@ func List(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	v := view.NewRelease(releases)
+	output, err := cmdcommon.GetOutput(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	v := view.NewRelease(releases, view.WithReleaseOutput(output))
 
 	cmdutil.ExitIfError(v.Render())
 }
@@ -0,0 +1,132 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/cli/safeexec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// userAliases are the aliases defined in the user config, see ExpandAlias.
+var userAliases map[string]string
+
+// ExpandAlias expands the user defined alias if the first argument is one.
+//
+// It runs before the command line is parsed, so the config file is read
+// directly from the path given by the config flag or the env var. The
+// builtin commands always take precedence over the aliases. It returns
+// true if the expanded alias is a shell alias, see RunShellAlias.
+func ExpandAlias(cmd *cobra.Command, args []string) ([]string, bool, error) {
+	path, err := aliasConfigFile(args)
+	if err != nil {
+		return args, false, nil
+	}
+	if userAliases, err = jiraConfig.LoadAliases(path); err != nil {
+		// Let the command report the broken config.
+		return args, false, nil
+	}
+
+	i := commandIndex(cmd, args)
+	if i < 0 || cmdcommon.IsBuiltinCommand(cmd, args[i]) {
+		return args, false, nil
+	}
+	name, rest := args[i], args[i+1:]
+	expansion, ok := userAliases[strings.ToLower(name)]
+	if !ok {
+		return args, false, nil
+	}
+
+	if jiraConfig.IsShellAlias(expansion) {
+		return jiraConfig.ShellAliasArgs(expansion, rest), true, nil
+	}
+
+	expanded, err := jiraConfig.ExpandAlias(expansion, rest, substitute)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to expand alias %q: %s", name, err)
+	}
+	// Keep the global flags given before the alias, eg: --config.
+	return append(slices.Clone(args[:i]), expanded...), false, nil
+}
+
+// commandIndex returns the index of the first argument that is not
+// a global flag or its value, -1 if there is no such argument.
+func commandIndex(cmd *cobra.Command, args []string) int {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch {
+		case a == "--":
+			return -1
+		case !strings.HasPrefix(a, "-") || a == "-":
+			return i
+		case strings.Contains(a, "="):
+			continue
+		}
+
+		var f *pflag.Flag
+		if strings.HasPrefix(a, "--") {
+			f = cmd.PersistentFlags().Lookup(a[2:])
+		} else if len(a) == 2 {
+			f = cmd.PersistentFlags().ShorthandLookup(a[1:])
+		}
+		// The value of a flag is the next argument unless the flag is a switch.
+		if f != nil && f.NoOptDefVal == "" {
+			i++
+		}
+	}
+	return -1
+}
+
+// RunShellAlias runs the shell alias with the arguments returned by
+// ExpandAlias and returns the exit code of the shell.
+func RunShellAlias(args []string) (int, error) {
+	sh, err := safeexec.LookPath("sh")
+	if err != nil {
+		return 1, fmt.Errorf("unable to run shell alias: %s", err)
+	}
+
+	c := exec.Command(sh, args...)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+
+	var exitErr *exec.ExitError
+	if err := c.Run(); errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), nil
+	} else if err != nil {
+		return 1, fmt.Errorf("unable to run shell alias: %s", err)
+	}
+	return 0, nil
+}
+
+// aliasConfigFile returns the config file in the same order of precedence as the config
+// loader: the config flag, JIRA_CONFIG_FILE env var and then the default location.
+func aliasConfigFile(args []string) (string, error) {
+	if f, ok := jiraConfig.ConfigFlag(args); ok {
+		return f, nil
+	}
+	if f := os.Getenv("JIRA_CONFIG_FILE"); f != "" {
+		return f, nil
+	}
+	return jiraConfig.DefaultFile()
+}
+
+// substitute runs the command substitution of an alias with the shell.
+func substitute(command string) (string, error) {
+	sh, err := safeexec.LookPath("sh")
+	if err != nil {
+		return "", err
+	}
+
+	c := exec.Command(sh, "-c", command)
+	c.Stdin, c.Stderr = os.Stdin, os.Stderr
+
+	out, err := c.Output()
+	return string(out), err
+}
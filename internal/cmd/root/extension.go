@@ -0,0 +1,84 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/extension"
+)
+
+// RunExtension runs the jira-<name> extension if the command is unknown.
+//
+// The global flags given before the command are applied and the config
+// is resolved, so that the extension gets the same server, login, project
+// and board as the builtin commands would. It returns false if there is
+// no such extension and the command should be run as usual.
+func RunExtension(cmd *cobra.Command, args []string) (int, bool, error) {
+	i := commandIndex(cmd, args)
+	if i < 0 || cmdcommon.IsBuiltinCommand(cmd, args[i]) {
+		return 0, false, nil
+	}
+
+	dir, err := extension.DefaultDir()
+	if err != nil {
+		return 0, false, nil
+	}
+	ext, err := extension.NewManager(dir).Find(args[i])
+	if err != nil {
+		return 0, false, nil
+	}
+
+	if err := cmd.ParseFlags(args[:i]); err != nil {
+		return 1, true, err
+	}
+	initConfig()
+
+	c := exec.Command(ext.Path, args[i+1:]...)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+	c.Env = append(os.Environ(), extensionEnv()...)
+
+	var exitErr *exec.ExitError
+	if err := c.Run(); errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), true, nil
+	} else if err != nil {
+		return 1, true, fmt.Errorf("unable to run extension %q: %s", ext.Name, err)
+	}
+	return 0, true, nil
+}
+
+// extensionEnv returns the resolved config passed to the extensions. The token
+// itself is never passed, the extensions can run 'jira auth token' if needed.
+func extensionEnv() []string {
+	server, login := viper.GetString("server"), viper.GetString("login")
+
+	source := api.TokenSourceNone
+	if t := api.ResolveToken(server, login); t.Value != "" {
+		source = t.Source
+	}
+
+	env := map[string]string{
+		"JIRA_SERVER":       server,
+		"JIRA_LOGIN":        login,
+		"JIRA_TOKEN_SOURCE": string(source),
+		"JIRA_PROJECT_KEY":  viper.GetString("project.key"),
+		"JIRA_BOARD_ID":     viper.GetString("board.id"),
+		"JIRA_BOARD_NAME":   viper.GetString("board.name"),
+		"JIRA_CONFIG_FILE":  viper.ConfigFileUsed(),
+	}
+	if exe, err := os.Executable(); err == nil {
+		env["JIRA_EXECUTABLE"] = exe
+	}
+
+	out := make([]string, 0, len(env))
+	for k, v := range env {
+		out = append(out, k+"="+v)
+	}
+	return out
+}
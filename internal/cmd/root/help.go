@@ -7,6 +7,8 @@ import (
 
 	"github.com/kr/text"
 	"github.com/spf13/cobra"
+
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
 )
 
 type helpEntry struct {
@@ -56,6 +58,9 @@ func getEntries(cmd *cobra.Command) []helpEntry {
 	appendIfNotEmpty(cmd.UseLine(), "USAGE")
 	appendIfNotEmpty(mainCmds, "MAIN COMMANDS")
 	appendIfNotEmpty(otherCmds, "OTHER COMMANDS")
+	if !cmd.HasParent() {
+		appendIfNotEmpty(aliasEntries(), "USER ALIASES")
+	}
 	appendIfNotEmpty(outdent(cmd.LocalFlags().FlagUsages()), "FLAGS")
 	appendIfNotEmpty(outdent(cmd.InheritedFlags().FlagUsages()), "INHERITED FLAGS")
 	if _, ok := cmd.Annotations["help:args"]; ok {
@@ -99,6 +104,21 @@ func groupCommands(cmds []*cobra.Command) ([]string, []string) {
 	return primary, secondary
 }
 
+func aliasEntries() []string {
+	names := jiraConfig.AliasNames(userAliases)
+
+	pad := 0
+	for _, name := range names {
+		pad = max(pad, len(name))
+	}
+
+	entries := make([]string, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, rpad(name, pad)+userAliases[name])
+	}
+	return entries
+}
+
 func outdent(s string) string {
 	lines, minIndent := strings.Split(s, "\n"), -1
 
@@ -4,17 +4,26 @@ import (
 	"fmt"
 	"os"
 	"slices"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/board"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/completion"
+	configCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/config"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/doctor"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/epic"
+	extensionCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/extension"
 	initCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/init"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/man"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/me"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/notify"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/open"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/project"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/release"
@@ -24,9 +33,6 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
-	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
-
-	"github.com/zalando/go-keyring"
 )
 
 const (
@@ -40,33 +46,38 @@ var (
 )
 
 func init() {
-	cobra.OnInitialize(func() {
-		if config != "" {
-			// 1. Command line flag has the highest priority
-			viper.SetConfigFile(config)
-		} else if configFile := os.Getenv("JIRA_CONFIG_FILE"); configFile != "" {
-			// 2. Environment variable has second priority
-			viper.SetConfigFile(configFile)
-		} else {
-			// 3. Default location has the lowest priority
-			home, err := cmdutil.GetConfigHome()
-			if err != nil {
-				cmdutil.Failed("Error: %s", err)
-				return
-			}
+	cobra.OnInitialize(initConfig)
+}
 
-			viper.AddConfigPath(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
-			viper.SetConfigName(jiraConfig.FileName)
-			viper.SetConfigType(jiraConfig.FileType)
+// initConfig loads the user config and merges the per-repository config over it.
+func initConfig() {
+	if config != "" {
+		// 1. Command line flag has the highest priority
+		viper.SetConfigFile(config)
+	} else if configFile := os.Getenv("JIRA_CONFIG_FILE"); configFile != "" {
+		// 2. Environment variable has second priority
+		viper.SetConfigFile(configFile)
+	} else {
+		// 3. Default location has the lowest priority
+		home, err := cmdutil.GetConfigHome()
+		if err != nil {
+			cmdutil.Failed("Error: %s", err)
+			return
 		}
 
-		viper.AutomaticEnv()
-		viper.SetEnvPrefix("jira")
+		viper.AddConfigPath(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
+		viper.SetConfigName(jiraConfig.FileName)
+		viper.SetConfigType(jiraConfig.FileType)
+	}
 
-		if err := viper.ReadInConfig(); err == nil && debug {
-			fmt.Printf("Using config file: %s\n", viper.ConfigFileUsed())
-		}
-	})
+	viper.AutomaticEnv()
+	viper.SetEnvPrefix("jira")
+
+	if err := viper.ReadInConfig(); err == nil && debug {
+		fmt.Printf("Using config file: %s\n", viper.ConfigFileUsed())
+	}
+
+	mergeLocalConfig()
 }
 
 // NewCmdRoot is a root command.
@@ -79,8 +90,10 @@ func NewCmdRoot() *cobra.Command {
 			return cmd.Help()
 		},
 		PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+			cmdutil.ExitIfError(cmdutil.ValidateOutputFormat())
+
 			subCmd := cmd.Name()
-			if !cmdRequireToken(subCmd) {
+			if !cmdRequireToken(subCmd) || !cmdRequireToken(topLevelCmd(cmd).Name()) {
 				return
 			}
 
@@ -113,12 +126,16 @@ func NewCmdRoot() *cobra.Command {
 		),
 	)
 	cmd.PersistentFlags().BoolVar(&debug, "debug", false, "Turn on debug output")
+	cmd.PersistentFlags().StringP("output", "o", "", fmt.Sprintf(
+		"Output format, one of: %s (can be set with JIRA_OUTPUT env var)", strings.Join(cmdutil.OutputFormats(), ", "),
+	))
 
 	cmd.SetHelpFunc(helpFunc)
 
 	_ = viper.BindPFlag("config", cmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("project.key", cmd.PersistentFlags().Lookup("project"))
 	_ = viper.BindPFlag("debug", cmd.PersistentFlags().Lookup("debug"))
+	_ = viper.BindPFlag("output", cmd.PersistentFlags().Lookup("output"))
 
 	addChildCommands(&cmd)
 
@@ -128,6 +145,8 @@ func NewCmdRoot() *cobra.Command {
 func addChildCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		initCmd.NewCmdInit(),
+		configCmd.NewCmdConfig(),
+		auth.NewCmdAuth(),
 		issue.NewCmdIssue(),
 		epic.NewCmdEpic(),
 		sprint.NewCmdSprint(),
@@ -135,17 +154,51 @@ func addChildCommands(cmd *cobra.Command) {
 		project.NewCmdProject(),
 		open.NewCmdOpen(),
 		me.NewCmdMe(),
+		notify.NewCmdNotify(),
 		serverinfo.NewCmdServerInfo(),
+		doctor.NewCmdDoctor(),
 		completion.NewCmdCompletion(),
 		version.NewCmdVersion(),
 		release.NewCmdRelease(),
+		jql.NewCmdJQL(),
+		alias.NewCmdAlias(),
+		extensionCmd.NewCmdExtension(),
 		man.NewCmdMan(),
 	)
 }
 
+// mergeLocalConfig merges the per-repository config, if any, over the user config.
+func mergeLocalConfig() {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return
+	}
+	file := jiraConfig.FindLocal(cwd)
+	if file == "" {
+		return
+	}
+
+	skipped, err := jiraConfig.MergeLocal(viper.GetViper(), file)
+	if err != nil {
+		cmdutil.Warn("Unable to merge local config file %s: %s", file, err)
+		return
+	}
+	if debug {
+		fmt.Printf("Merged local config file: %s\n", file)
+		if len(skipped) > 0 {
+			fmt.Printf("Ignored keys restricted to the user config: %s\n", strings.Join(skipped, ", "))
+		}
+	}
+}
+
 func cmdRequireToken(cmd string) bool {
 	allowList := []string{
 		"init",
+		"config",
+		"auth",
+		"alias",
+		"extension",
+		"doctor",
 		"help",
 		"jira",
 		"version",
@@ -156,19 +209,24 @@ func cmdRequireToken(cmd string) bool {
 	return !slices.Contains(allowList, cmd)
 }
 
-func checkForJiraToken(server string, login string) {
-	if os.Getenv("JIRA_API_TOKEN") != "" {
-		return
+// topLevelCmd returns the first level subcommand the given command belongs to.
+func topLevelCmd(cmd *cobra.Command) *cobra.Command {
+	for cmd.HasParent() && cmd.Parent().HasParent() {
+		cmd = cmd.Parent()
 	}
+	return cmd
+}
 
-	netrcConfig, _ := netrc.Read(server, login)
-	if netrcConfig != nil {
+func checkForJiraToken(server string, login string) {
+	t := api.ResolveToken(server, login)
+	if t.Source != api.TokenSourceNone {
 		return
 	}
-
-	secret, _ := keyring.Get("jira-cli", login)
-	if secret != "" {
-		return
+	if t.Err != nil {
+		cmdutil.FailedWithCode(cmdutil.ExitAuth, "Unable to get the API token: %s", t.Err)
+	}
+	if cmdutil.IsStructuredOutput() {
+		cmdutil.FailedWithCode(cmdutil.ExitAuth, "Missing Jira API token, see: %s", jiraCLIHelpLink)
 	}
 
 	msg := fmt.Sprintf(`The tool needs a Jira API token to function.
@@ -179,6 +237,8 @@ For local server: you can use the password you use to log in to Jira for basic a
 After generating the token, you can either:
   - Export API token to your shell as a JIRA_API_TOKEN env variable
   - Or, you can use a .netrc file to define required machine details
+  - Or, run 'jira auth login' to store the token in your keyring
+  - Or, set 'credential_helper' in the config to get the token from an external command
 
 Once you are done with the above steps, run 'jira init' to generate the config if you haven't already.
 
@@ -186,5 +246,5 @@ For more details, see: %s
 `, jiraAPITokenLink, jiraCLIHelpLink)
 
 	cmdutil.Warn(msg)
-	os.Exit(1)
+	os.Exit(cmdutil.ExitAuth)
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -11,13 +12,17 @@ import (
 
 // NewCmdServerInfo is a server info command.
 func NewCmdServerInfo() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "serverinfo",
 		Short:   "Displays information about the Jira instance",
 		Long:    "Displays information about the Jira instance.",
 		Aliases: []string{"systeminfo"},
 		Run:     serverInfo,
 	}
+
+	cmdcommon.SetOutputFlags(&cmd)
+
+	return &cmd
 }
 
 func serverInfo(cmd *cobra.Command, _ []string) {
@@ -36,7 +41,10 @@ func serverInfo(cmd *cobra.Command, _ []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
-	v := view.NewServerInfo(info)
+	output, err := cmdcommon.GetOutput(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	v := view.NewServerInfo(info, view.WithServerInfoOutput(output))
 
 	cmdutil.ExitIfError(v.Render())
 }
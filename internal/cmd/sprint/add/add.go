@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 )
@@ -70,6 +71,13 @@ func add(cmd *cobra.Command, args []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
+	if cmdutil.IsStructuredOutput() {
+		res := cmdcommon.NewSprintResult(cmdcommon.ResultAdded, params.sprintID)
+		res.Issues = params.issues
+		cmdcommon.PrintResult(res)
+		return
+	}
+
 	cmdutil.Success(fmt.Sprintf("Issues added to the sprint %s\n%s", params.sprintID, cmdutil.GenerateServerBrowseURL(server, project)))
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/spf13/cobra"
@@ -61,6 +62,11 @@ func closeSprint(cmd *cobra.Command, args []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
+	if cmdutil.IsStructuredOutput() {
+		cmdcommon.PrintResult(cmdcommon.NewSprintResult(cmdcommon.ResultClosed, params.sprintID))
+		return
+	}
+
 	cmdutil.Success(fmt.Sprintf("Sprint %s has been closed.", params.sprintID))
 }
 
@@ -11,10 +11,12 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
@@ -43,7 +45,10 @@ $ jira sprint list --table --plain --columns name,start,end
 $ jira sprint list <SPRINT_ID> --plain --columns type,key,summary
 
 # Display sprint issues in a plain table view and show all fields
-$ jira sprint list <SPRINT_ID> --plain --no-truncate`
+$ jira sprint list <SPRINT_ID> --plain --no-truncate
+
+# Refresh the issues in the current sprint every minute and notify when they change
+$ jira sprint list --current --watch --notify osc9`
 )
 
 // NewCmdList is a sprint list command.
@@ -79,6 +84,8 @@ func sprintList(cmd *cobra.Command, args []string) {
 
 	client := api.DefaultClient(debug)
 
+	cmdcommon.ApplySavedQuery(cmd)
+
 	sprintQuery, err := query.NewSprint(cmd.Flags())
 	cmdutil.ExitIfError(err)
 
@@ -93,6 +100,11 @@ func sprintList(cmd *cobra.Command, args []string) {
 }
 
 func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID, sprintID int, project, server string, client *jira.Client, sprint *jira.Sprint) {
+	watch, err := cmdcommon.GetWatch(flags)
+	cmdutil.ExitIfError(err)
+
+	var pager *api.Pager
+
 	issues, err := func() ([]*jira.Issue, error) {
 		s := cmdutil.Info("Fetching sprint issues...")
 		defer s.Stop()
@@ -101,15 +113,25 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 		if err != nil {
 			return nil, err
 		}
-		resp, err := client.SprintIssues(sprintID, q.Get(), q.Params().From, q.Params().Limit)
-		if err != nil {
-			return nil, err
+		jql := q.Get()
+		newPager := func() *api.Pager {
+			return api.NewPager(func(from, limit uint) (*jira.SearchResult, error) {
+				return client.SprintIssues(sprintID, jql, from, limit)
+			}, q.Params().From, q.Params().Limit)
+		}
+		if watch != nil {
+			watch.Pager = func() view.IssuePager { return newPager() }
 		}
-		return resp.Issues, nil
+
+		pager = newPager()
+		return pager.Next()
 	}()
 	cmdutil.ExitIfError(err)
 
 	if len(issues) == 0 {
+		if cmdcommon.RenderEmptyList() {
+			return
+		}
 		fmt.Println()
 		cmdutil.Failed("No result found for given query in project %q", project)
 		return
@@ -139,30 +161,29 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 	var ft string
 	if sprint != nil {
 		if sprint.Status == jira.SprintStateFuture {
-			ft = fmt.Sprintf(
-				"Showing %d results for project %q in sprint #%d ➤ %s (Future Sprint)",
-				len(issues), project, sprint.ID, sprint.Name,
-			)
+			ft = fmt.Sprintf("in sprint #%d ➤ %s (Future Sprint)", sprint.ID, sprint.Name)
 		} else {
 			ft = fmt.Sprintf(
-				"Showing %d results for project %q in sprint #%d ➤ %s (%s - %s)",
-				len(issues), project, sprint.ID, sprint.Name,
+				"in sprint #%d ➤ %s (%s - %s)",
+				sprint.ID, sprint.Name,
 				cmdutil.FormatDateTimeHuman(sprint.StartDate, time.RFC3339),
 				cmdutil.FormatDateTimeHuman(sprint.EndDate, time.RFC3339),
 			)
 		}
 	} else {
-		ft = fmt.Sprintf(
-			"Showing %d results for project %q in sprint #%d",
-			len(issues), project, sprintID,
-		)
+		ft = fmt.Sprintf("in sprint #%d", sprintID)
 	}
 
+	output, err := cmdcommon.GetOutput(flags)
+	cmdutil.ExitIfError(err)
+
 	v := view.IssueList{
-		Project:    project,
-		Server:     server,
-		Data:       issues,
-		FooterText: ft,
+		Project:       project,
+		Server:        server,
+		Data:          issues,
+		Pager:         pager,
+		FooterContext: ft,
+		Watch:         watch,
 		Refresh: func() {
 			singleSprintView(sprintQuery, flags, boardID, sprintID, project, server, client, nil)
 		},
@@ -181,6 +202,7 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
 			Timezone:   viper.GetString("timezone"),
+			Output:     output,
 		},
 	}
 
@@ -195,6 +217,9 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 		return client.SprintsInBoards([]int{boardID}, sprintQuery.Get(), numSprints)
 	}()
 	if len(sprints) == 0 {
+		if cmdcommon.RenderEmptyList() {
+			return
+		}
 		fmt.Println()
 		cmdutil.Failed("No result found for given query in project %q", project)
 		return
@@ -221,21 +246,24 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 	columns, err := flags.GetString("columns")
 	cmdutil.ExitIfError(err)
 
+	output, err := cmdcommon.GetOutput(flags)
+	cmdutil.ExitIfError(err)
+
+	layout, saveLayout := cmdcommon.TableLayout("sprint_list")
+
 	v := view.SprintList{
 		Project: project,
 		Board:   viper.GetString("board.name"),
 		Server:  server,
 		Data:    sprints,
-		Issues: func(boardID, sprintID int) []*jira.Issue {
-			iq, err := getIssueQuery(project, flags, sprintQuery.Params().ShowAllIssues)
-			if err != nil {
-				return []*jira.Issue{}
-			}
-			resp, err := client.SprintIssues(sprintID, iq.Get(), sprintQuery.Params().From, sprintQuery.Params().Limit)
-			if err != nil {
-				return []*jira.Issue{}
-			}
-			return resp.Issues
+		Issues: func(boardID, sprintID int) view.IssuePager {
+			return api.NewPager(func(from, limit uint) (*jira.SearchResult, error) {
+				iq, err := getIssueQuery(project, flags, sprintQuery.Params().ShowAllIssues)
+				if err != nil {
+					return nil, err
+				}
+				return client.SprintIssues(sprintID, iq.Get(), from, limit)
+			}, sprintQuery.Params().From, sprintQuery.Params().Limit)
 		},
 		Display: view.DisplayFormat{
 			Plain:        plain,
@@ -248,7 +276,10 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 				return []string{}
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
+			Layout:     layout,
+			SaveLayout: saveLayout,
 			Timezone:   viper.GetString("timezone"),
+			Output:     output,
 		},
 	}
 
@@ -263,17 +294,16 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 }
 
 func getIssueQuery(project string, flags query.FlagParser, showAll bool) (*query.Issue, error) {
-	q, err := query.NewIssue(project, flags)
+	q, err := query.NewIssue(project, flags, cmdcommon.IssueQueryOptions()...)
 	if err != nil {
 		return nil, err
 	}
 	if showAll {
-		allIssues := "project IS NOT EMPTY"
-		if q.Params().JQL != "" {
-			allIssues += " AND " + q.Params().JQL
+		merged, err := jql.Merge("project IS NOT EMPTY", q.Params().JQL)
+		if err != nil {
+			return nil, cmdutil.Invalidf("invalid query: %s", err)
 		}
-		q.Params().JQL = allIssues
-
+		q.Params().JQL = merged
 	}
 	return q, nil
 }
@@ -310,4 +340,5 @@ func hideFlags(cmd *cobra.Command) {
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("updated-before"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("label"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("reverse"))
+	cmdutil.ExitIfError(cmd.Flags().MarkHidden("sprint"))
 }
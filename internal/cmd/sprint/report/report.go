@@ -0,0 +1,143 @@
+package report
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	numSprints = 50 // This is the maximum result returned by Jira API at once.
+	helpText   = `Report shows the committed and the completed issues of a sprint, the issues added to and
+removed from it after it started, and its burndown.
+
+The report is computed from the changelog of the issues in the sprint. The work is measured in
+story points if the story points field is set with issue.fields.story_points in the config, or
+found among the configured custom fields, and in issues otherwise. The issues removed from the
+sprint are not returned by Jira and are not counted.
+
+The current active sprint of the board is reported if the sprint ID is not given. The report is
+displayed as a chart by default. Use --plain or --csv to display the burndown as a table, and
+--json or --template to get the full report.`
+
+	examples = `$ jira sprint report
+
+# Report a sprint
+$ jira sprint report 123
+
+# Report the previous sprint
+$ jira sprint report --prev
+
+# Burndown of the current sprint as CSV
+$ jira sprint report --csv
+
+# Full report as JSON
+$ jira sprint report 123 --json all`
+)
+
+// NewCmdReport is a sprint report command.
+func NewCmdReport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "report [SPRINT_ID]",
+		Short:   "Report shows the metrics and the burndown of a sprint",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			"help:args": "[SPRINT_ID]\tID of the sprint, defaults to the current active sprint",
+		},
+		Run: report,
+	}
+
+	cmd.Flags().Bool("prev", false, "Report the previous closed sprint")
+	cmd.Flags().Bool("plain", false, "Display the burndown in a plain table view")
+	cmd.Flags().Bool("csv", false, "Display the burndown in CSV format")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain or --csv")
+	cmdcommon.SetOutputFlags(&cmd)
+
+	return &cmd
+}
+
+func report(cmd *cobra.Command, args []string) {
+	boardID := viper.GetInt("board.id")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	prev, err := cmd.Flags().GetBool("prev")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+	points := cmdcommon.StoryPointsField()
+
+	r, err := func() (*view.SprintReport, error) {
+		s := cmdutil.Info("Fetching sprint issues...")
+		defer s.Stop()
+
+		sprint, err := getSprint(client, args, boardID, prev)
+		if err != nil {
+			return nil, err
+		}
+		issues, err := client.SprintIssueHistory(sprint.ID, points.ID)
+		if err != nil {
+			return nil, err
+		}
+		return view.NewSprintReport(sprint, issues, points, time.Now())
+	}()
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	csv, err := cmd.Flags().GetBool("csv")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmdcommon.GetOutput(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	v := view.SprintReportView{
+		Report: r,
+		Display: view.DisplayFormat{
+			Plain:     plain,
+			CSV:       csv,
+			NoHeaders: noHeaders,
+			Timezone:  viper.GetString("timezone"),
+			Output:    output,
+		},
+	}
+
+	cmdutil.ExitIfError(v.Render())
+}
+
+// getSprint returns the sprint with the given ID, or the current or the previous sprint of the board.
+func getSprint(client *jira.Client, args []string, boardID int, prev bool) (*jira.Sprint, error) {
+	if len(args) > 0 {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid sprint ID %q", args[0])
+		}
+		return client.GetSprint(id)
+	}
+
+	state := jira.SprintStateActive
+	if prev {
+		state = jira.SprintStateClosed
+	}
+	sprints := client.SprintsInBoards([]int{boardID}, fmt.Sprintf("state=%s", state), numSprints)
+	if len(sprints) == 0 {
+		return nil, fmt.Errorf("no %s sprint found in board %d", state, boardID)
+	}
+	return sprints[0], nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/add"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/close"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/report"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/velocity"
 )
 
 const helpText = `Sprint manage sprints in a project board. See available commands below.`
@@ -24,8 +26,10 @@ func NewCmdSprint() *cobra.Command {
 	lc := list.NewCmdList()
 	ac := add.NewCmdAdd()
 	cc := close.NewCmdClose()
+	rc := report.NewCmdReport()
+	vc := velocity.NewCmdVelocity()
 
-	cmd.AddCommand(lc, ac, cc)
+	cmd.AddCommand(lc, ac, cc, rc, vc)
 
 	list.SetFlags(lc)
 
@@ -0,0 +1,133 @@
+package velocity
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+)
+
+const (
+	helpText = `Velocity shows the work committed and completed in the last closed sprints of a board.
+
+The work is measured in story points if the story points field is set with issue.fields.story_points
+in the config, or found among the configured custom fields, and in issues otherwise. See 'jira sprint report'
+to know how the committed and the completed work of a sprint is computed.
+
+The velocity is displayed as a chart by default. Use --plain or --csv to display it as a table,
+and --json or --template to get the full report.`
+
+	examples = `$ jira sprint velocity
+
+# Velocity of the last 10 sprints of a board
+$ jira sprint velocity --board 2 --last 10
+
+# Velocity as CSV
+$ jira sprint velocity --csv`
+
+	defaultLast = 7
+	// maxLast is the maximum number of sprints returned by Jira API at once.
+	maxLast = 50
+)
+
+// NewCmdVelocity is a sprint velocity command.
+func NewCmdVelocity() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "velocity",
+		Short:   "Velocity shows the work completed in the last sprints of a board",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     velocity,
+	}
+
+	cmd.Flags().Int("board", 0, "ID of the board, defaults to the board in the config")
+	cmd.Flags().Uint("last", defaultLast, fmt.Sprintf("Number of the last closed sprints, up to %d", maxLast))
+	cmd.Flags().Bool("plain", false, "Display the velocity in a plain table view")
+	cmd.Flags().Bool("csv", false, "Display the velocity in CSV format")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain or --csv")
+	cmdcommon.SetOutputFlags(&cmd)
+
+	return &cmd
+}
+
+func velocity(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	boardID, err := cmd.Flags().GetInt("board")
+	cmdutil.ExitIfError(err)
+	if boardID == 0 {
+		boardID = viper.GetInt("board.id")
+	}
+
+	last, err := cmd.Flags().GetUint("last")
+	cmdutil.ExitIfError(err)
+	if last == 0 || last > maxLast {
+		cmdutil.Failed("The number of sprints must be between 1 and %d", maxLast)
+	}
+
+	client := api.DefaultClient(debug)
+	points := cmdcommon.StoryPointsField()
+
+	reports, err := func() ([]*view.SprintReport, error) {
+		s := cmdutil.Info("Fetching sprint issues...")
+		defer s.Stop()
+
+		// The sprints are paged by the limit, so the maximum is fetched to avoid a request per few sprints.
+		sprints := client.SprintsInBoards([]int{boardID}, "state=closed", maxLast)
+		if len(sprints) == 0 {
+			return nil, fmt.Errorf("no closed sprint found in board %d", boardID)
+		}
+		sprints = sprints[:min(len(sprints), int(last))]
+		// The sprints are in the descending order, the chart reads from the oldest.
+		slices.Reverse(sprints)
+
+		reports := make([]*view.SprintReport, 0, len(sprints))
+		for _, sprint := range sprints {
+			issues, err := client.SprintIssueHistory(sprint.ID, points.ID)
+			if err != nil {
+				return nil, err
+			}
+			r, err := view.NewSprintReport(sprint, issues, points, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			reports = append(reports, r)
+		}
+		return reports, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	csv, err := cmd.Flags().GetBool("csv")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmdcommon.GetOutput(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	v := view.VelocityView{
+		Report: view.NewVelocityReport(reports),
+		Display: view.DisplayFormat{
+			Plain:     plain,
+			CSV:       csv,
+			NoHeaders: noHeaders,
+			Timezone:  viper.GetString("timezone"),
+			Output:    output,
+		},
+	}
+
+	cmdutil.ExitIfError(v.Render())
+}
@@ -3,9 +3,11 @@ package version
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	v "github.com/ankitpokhrel/jira-cli/internal/version"
 )
 
@@ -20,5 +22,9 @@ func NewCmdVersion() *cobra.Command {
 }
 
 func version(*cobra.Command, []string) {
+	if cmdutil.IsStructuredOutput() {
+		cmdutil.ExitIfError(cmdutil.Encode(os.Stdout, cmdutil.OutputFormat(), v.Get()))
+		return
+	}
 	fmt.Println(v.Info())
 }
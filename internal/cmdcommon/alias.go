@@ -0,0 +1,21 @@
+package cmdcommon
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// IsBuiltinCommand checks if the name is a top level command or one of its aliases.
+// The builtin commands always take precedence over the user defined aliases.
+func IsBuiltinCommand(cmd *cobra.Command, name string) bool {
+	if name == "help" || strings.HasPrefix(name, "__complete") {
+		return true
+	}
+	for _, c := range cmd.Root().Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
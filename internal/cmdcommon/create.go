@@ -268,3 +268,18 @@ Invalid custom fields used in the command: %s`,
 		)
 	}
 }
+
+// ApplyIssueDefaults fills labels, components and template that were not
+// passed explicitly with the defaults defined in the config. The defaults are
+// usually defined in a per-repository config file.
+func ApplyIssueDefaults(params *CreateParams) {
+	if len(params.Labels) == 0 {
+		params.Labels = viper.GetStringSlice("issue.defaults.labels")
+	}
+	if len(params.Components) == 0 {
+		params.Components = viper.GetStringSlice("issue.defaults.components")
+	}
+	if params.Template == "" && params.Body == "" && !cmdutil.StdinHasData() {
+		params.Template = viper.GetString("issue.defaults.template")
+	}
+}
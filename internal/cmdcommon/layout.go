@@ -0,0 +1,14 @@
+package cmdcommon
+
+import (
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// TableLayout returns the saved layout of the interactive table of the
+// given view, eg: issue_list, and a func to save it when it changes.
+func TableLayout(view string) (tui.TableLayout, tui.LayoutSaveFunc) {
+	return jiraConfig.TableLayout(view), func(layout tui.TableLayout) error {
+		return jiraConfig.SaveTableLayout(view, layout)
+	}
+}
@@ -0,0 +1,89 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+)
+
+const (
+	flagTemplate     = "template"
+	flagTemplateFile = "template-file"
+	flagJSON         = "json"
+)
+
+// SetOutputFlags sets flags to format the output with a template or as JSON.
+func SetOutputFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagTemplate, "", "Format the output using a Go template, eg: '{{range .}}{{.key}}{{\"\\n\"}}{{end}}'\n"+
+		"Available funcs: adf, md, date, join, color, json, upper, lower, truncate")
+	cmd.Flags().String(flagTemplateFile, "", "Format the output using a Go template from the file")
+	cmd.Flags().String(flagJSON, "", fmt.Sprintf(
+		"Print JSON output with the given comma separated fields, eg: key,fields.summary\n"+
+			"Use %q to print all fields", view.OutputJSONAll,
+	))
+
+	cmd.MarkFlagsMutuallyExclusive(flagTemplate, flagTemplateFile)
+}
+
+// GlobalOutput returns the output requested with the global --output flag.
+func GlobalOutput() view.Output {
+	out := view.Output{Timezone: viper.GetString("timezone")}
+	if cmdutil.IsStructuredOutput() {
+		out.Format = cmdutil.OutputFormat()
+	}
+	return out
+}
+
+// GetOutput parses the output flags set by SetOutputFlags
+// along with the global --output flag.
+func GetOutput(flags query.FlagParser) (view.Output, error) {
+	out := GlobalOutput()
+
+	tmpl, err := flags.GetString(flagTemplate)
+	if err != nil {
+		return out, err
+	}
+	file, err := flags.GetString(flagTemplateFile)
+	if err != nil {
+		return out, err
+	}
+	if file != "" {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return out, err
+		}
+		tmpl = string(b)
+	}
+	out.Template = tmpl
+
+	fields, err := flags.GetString(flagJSON)
+	if err != nil {
+		return out, err
+	}
+	if fields = strings.TrimSpace(fields); fields != "" {
+		out.JSON = true
+		if fields != view.OutputJSONAll {
+			out.Fields = strings.Split(fields, ",")
+		}
+	}
+
+	return out, nil
+}
+
+// RenderEmptyList prints an empty list if a structured output is requested, so
+// scripts can tell an empty result apart from a failure. It reports whether the
+// list was printed.
+func RenderEmptyList() bool {
+	if !cmdutil.IsStructuredOutput() {
+		return false
+	}
+	cmdutil.ExitIfError(GlobalOutput().Render(os.Stdout, []interface{}{}))
+	return true
+}
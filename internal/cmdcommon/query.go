@@ -0,0 +1,89 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
+)
+
+// SetQueryFlags sets flags to run and save named queries.
+func SetQueryFlags(cmd *cobra.Command) {
+	cmd.Flags().String("query", "", "Run a saved query, see 'jira jql list'")
+	cmd.Flags().String("save-query", "", "Save the query passed with --jql under the given name")
+}
+
+// ApplySavedQuery resolves the --query flag to the saved query and combines
+// it with the raw JQL from the --jql flag. The query passed with --jql is
+// saved if the --save-query flag is set.
+//
+// Both flags are cleared once applied, so it is safe to call it again
+// when the list is refreshed.
+func ApplySavedQuery(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	if flags.Lookup("query") == nil {
+		return
+	}
+
+	raw, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	save, err := flags.GetString("save-query")
+	cmdutil.ExitIfError(err)
+
+	if save != "" {
+		if raw == "" {
+			cmdutil.FailedWithCode(cmdutil.ExitValidation, "Nothing to save, pass the query with --jql flag")
+		}
+		cmdutil.ExitIfError(cmdutil.Invalid(jiraConfig.ValidateQueryName(save)))
+
+		path, err := jiraConfig.SaveQuery(save, raw)
+		cmdutil.ExitIfError(err)
+
+		fmt.Fprintf(os.Stderr, "Saved query %q to %s\n", save, path)
+		cmdutil.ExitIfError(flags.Set("save-query", ""))
+	}
+
+	name, err := flags.GetString("query")
+	cmdutil.ExitIfError(err)
+
+	if name == "" {
+		return
+	}
+
+	saved, ok := jiraConfig.Query(name)
+	if !ok {
+		cmdutil.FailedWithCode(cmdutil.ExitValidation, "Query %q not found, see 'jira jql list'", name)
+	}
+
+	q, err := jql.Merge(saved, raw)
+	if err != nil {
+		cmdutil.ExitIfError(cmdutil.Invalidf("invalid query: %s", err))
+	}
+	cmdutil.ExitIfError(flags.Set("jql", q))
+	cmdutil.ExitIfError(flags.Set("query", ""))
+}
+
+// IssueQueryOptions returns the options to resolve the fields
+// of --custom, --team and --epic filters of the issue list.
+func IssueQueryOptions() []query.IssueOption {
+	opts := make([]query.IssueOption, 0, 2)
+
+	if fields, err := GetConfiguredCustomFields(); err == nil {
+		opts = append(opts, query.WithCustomFields(fields))
+	}
+	// The parent field works for epics of all project types in the cloud,
+	// older local installations still need the epic link field.
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		opts = append(opts, query.WithEpicLinkField(viper.GetString("epic.link")))
+	}
+
+	return opts
+}
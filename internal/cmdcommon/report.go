@@ -0,0 +1,38 @@
+package cmdcommon
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+)
+
+// storyPointsNames are the names of the story points field in the classic and the next-gen projects.
+var storyPointsNames = []string{view.DefaultPointsName, "Story point estimate"}
+
+// StoryPointsField returns the story points field used in the sprint reports. It is either set
+// with issue.fields.story_points or found by its name among the configured custom fields.
+// The reports are measured in issues if the field is not found.
+func StoryPointsField() view.PointsField {
+	fields, _ := GetConfiguredCustomFields()
+
+	if id := viper.GetString("issue.fields.story_points"); id != "" {
+		f := view.PointsField{ID: id, Name: view.DefaultPointsName}
+		for _, c := range fields {
+			if c.Key == id {
+				f.Name = c.Name
+			}
+		}
+		return f
+	}
+
+	for _, name := range storyPointsNames {
+		for _, c := range fields {
+			if strings.EqualFold(c.Name, name) {
+				return view.PointsField{ID: c.Key, Name: c.Name}
+			}
+		}
+	}
+	return view.PointsField{}
+}
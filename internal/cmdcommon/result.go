@@ -0,0 +1,160 @@
+package cmdcommon
+
+import (
+	"io"
+	"os"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+// Actions reported in the result of a mutation.
+const (
+	ResultCreated   = "created"
+	ResultUpdated   = "updated"
+	ResultMoved     = "moved"
+	ResultAssigned  = "assigned"
+	ResultLinked    = "linked"
+	ResultUnlinked  = "unlinked"
+	ResultCloned    = "cloned"
+	ResultDeleted   = "deleted"
+	ResultCommented = "commented"
+	ResultLogged    = "logged"
+	ResultWatched   = "watched"
+	ResultAdded     = "added"
+	ResultRemoved   = "removed"
+	ResultClosed    = "closed"
+	ResultSet       = "set"
+	ResultUnset     = "unset"
+	ResultEdited    = "edited"
+	ResultRefreshed = "refreshed"
+	ResultUnchanged = "unchanged"
+	ResultLoggedIn  = "logged_in"
+	ResultLoggedOut = "logged_out"
+	ResultInstalled = "installed"
+	ResultUpgraded  = "upgraded"
+)
+
+// Result is a machine-readable result of a command that changes an issue,
+// a sprint, the config or the local setup. It is printed instead of the
+// success message if a structured output is requested with the global
+// --output flag.
+type Result struct {
+	Action   string      `json:"action" yaml:"action"`
+	Key      string      `json:"key,omitempty" yaml:"key,omitempty"`
+	ID       string      `json:"id,omitempty" yaml:"id,omitempty"`
+	URL      string      `json:"url,omitempty" yaml:"url,omitempty"`
+	Status   string      `json:"status,omitempty" yaml:"status,omitempty"`
+	Assignee string      `json:"assignee,omitempty" yaml:"assignee,omitempty"`
+	User     string      `json:"user,omitempty" yaml:"user,omitempty"`
+	Link     *ResultLink `json:"link,omitempty" yaml:"link,omitempty"`
+	Remote   *ResultWeb  `json:"remote,omitempty" yaml:"remote,omitempty"`
+	Sprint   string      `json:"sprint,omitempty" yaml:"sprint,omitempty"`
+	Issues   []string    `json:"issues,omitempty" yaml:"issues,omitempty"`
+	Name     string      `json:"name,omitempty" yaml:"name,omitempty"`
+	Value    string      `json:"value,omitempty" yaml:"value,omitempty"`
+	Path     string      `json:"path,omitempty" yaml:"path,omitempty"`
+	Changes  []string    `json:"changes,omitempty" yaml:"changes,omitempty"`
+	Errors   []string    `json:"errors" yaml:"errors"`
+}
+
+// ResultLink is a link created between two issues.
+type ResultLink struct {
+	Type    string `json:"type,omitempty" yaml:"type,omitempty"`
+	Inward  string `json:"inward" yaml:"inward"`
+	Outward string `json:"outward" yaml:"outward"`
+}
+
+// ResultWeb is a remote web link added to an issue.
+type ResultWeb struct {
+	URL   string `json:"url" yaml:"url"`
+	Title string `json:"title" yaml:"title"`
+}
+
+// NewResult creates a result for the issue.
+func NewResult(action, server, key string) *Result {
+	return &Result{
+		Action: action,
+		Key:    key,
+		URL:    cmdutil.GenerateServerBrowseURL(server, key),
+		Errors: []string{},
+	}
+}
+
+// NewIssuesResult creates a result for a change made to several issues.
+func NewIssuesResult(action string, issues []string) *Result {
+	return &Result{
+		Action: action,
+		Issues: issues,
+		Errors: []string{},
+	}
+}
+
+// NewSprintResult creates a result for the sprint.
+func NewSprintResult(action, sprintID string) *Result {
+	return &Result{
+		Action: action,
+		Sprint: sprintID,
+		Errors: []string{},
+	}
+}
+
+// NewNamedResult creates a result for a config key, an alias, a saved
+// query, an extension or anything else that is not an issue.
+func NewNamedResult(action, name string) *Result {
+	return &Result{
+		Action: action,
+		Name:   name,
+		Errors: []string{},
+	}
+}
+
+// NewFileResult creates a result for a change made to the file.
+func NewFileResult(action, path string) *Result {
+	return &Result{
+		Action: action,
+		Path:   path,
+		Errors: []string{},
+	}
+}
+
+// NewAuthResult creates a result for the user logging in to or out of the server.
+func NewAuthResult(action, server, user string) *Result {
+	return &Result{
+		Action: action,
+		URL:    server,
+		User:   user,
+		Errors: []string{},
+	}
+}
+
+// AddError records an error of a step that failed after the main change went through.
+func (r *Result) AddError(err error) {
+	if err != nil {
+		r.Errors = append(r.Errors, err.Error())
+	}
+}
+
+// Write writes the result in the requested structured format.
+func (r *Result) Write(w io.Writer) error {
+	return cmdutil.Encode(w, cmdutil.OutputFormat(), r)
+}
+
+// PrintResult prints the result to stdout and exits with the partial failure
+// code if any of the follow-up steps failed.
+func PrintResult(r *Result) {
+	cmdutil.ExitIfError(r.Write(os.Stdout))
+	if len(r.Errors) > 0 {
+		os.Exit(cmdutil.ExitPartialFailure)
+	}
+}
+
+// PrintResults prints the results as a list and exits with the partial
+// failure code if any of them has errors.
+func PrintResults(rs []*Result) {
+	cmdutil.ExitIfError(GlobalOutput().Render(os.Stdout, rs))
+	for _, r := range rs {
+		if len(r.Errors) > 0 {
+			os.Exit(cmdutil.ExitPartialFailure)
+		}
+	}
+}
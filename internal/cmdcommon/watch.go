@@ -0,0 +1,69 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	flagWatch  = "watch"
+	flagNotify = "notify"
+
+	defaultWatchInterval = time.Minute
+	// MinWatchInterval is the shortest interval a query can be polled on.
+	MinWatchInterval = 10 * time.Second
+)
+
+// SetWatchFlags sets flags to refresh the list on an interval.
+func SetWatchFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagWatch, "", fmt.Sprintf(
+		"Refresh the list on the given interval and highlight the issues that changed, eg: --watch=30s\n"+
+			"Defaults to %s if the interval is not set. Works only in the interactive mode", defaultWatchInterval,
+	))
+	cmd.Flags().Lookup(flagWatch).NoOptDefVal = defaultWatchInterval.String()
+	cmd.Flags().String(flagNotify, "", "Notify when the issues change in the watch mode\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(tui.Notifications(), ", ")))
+}
+
+// GetWatch parses the flags set by SetWatchFlags. It returns nil if the list is not watched.
+func GetWatch(flags query.FlagParser) (*view.Watch, error) {
+	interval, err := flags.GetString(flagWatch)
+	if err != nil || interval == "" {
+		return nil, err
+	}
+	d, err := ParseWatchInterval(interval)
+	if err != nil {
+		return nil, err
+	}
+
+	notify, err := flags.GetString(flagNotify)
+	if err != nil {
+		return nil, err
+	}
+	notify = strings.ToLower(notify)
+	if notify != "" && !slices.Contains(tui.Notifications(), notify) {
+		return nil, fmt.Errorf("invalid notification %q, expected one of: %s", notify, strings.Join(tui.Notifications(), ", "))
+	}
+
+	return &view.Watch{Interval: d, Notify: notify}, nil
+}
+
+// ParseWatchInterval parses the interval to poll a query on, eg: 30s or 5m.
+func ParseWatchInterval(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid interval %q, eg: 30s or 5m", s)
+	}
+	if d < MinWatchInterval {
+		return 0, fmt.Errorf("the interval must be at least %s", MinWatchInterval)
+	}
+	return d, nil
+}
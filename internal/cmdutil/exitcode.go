@@ -0,0 +1,156 @@
+package cmdutil
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Stable exit codes returned by the tool. The codes are shared with
+// the non-interactive `jira init`, so scripts can handle both alike.
+const (
+	// ExitOK is returned on success.
+	ExitOK = 0
+	// ExitError is returned for errors that don't fit any other category.
+	ExitError = 1
+	// ExitValidation is returned if the input is missing or invalid.
+	ExitValidation = 2
+	// ExitAuth is returned if the server rejects the credentials.
+	ExitAuth = 4
+	// ExitServer is returned if the server can't be reached or fails.
+	ExitServer = 5
+	// ExitNotFound is returned if the requested resource doesn't exist.
+	ExitNotFound = 6
+	// ExitPartialFailure is returned if some of the requests in a batch failed.
+	ExitPartialFailure = 8
+)
+
+// Error codes used in the structured error output.
+const (
+	ErrCodeError          = "error"
+	ErrCodeValidation     = "invalid_value"
+	ErrCodeAuth           = "auth_failed"
+	ErrCodeServer         = "server_error"
+	ErrCodeNotFound       = "not_found"
+	ErrCodePartialFailure = "partial_failure"
+)
+
+// ValidationError wraps an error caused by an invalid user input.
+type ValidationError struct {
+	Err error
+}
+
+// Invalid marks the error as caused by an invalid user input.
+func Invalid(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ValidationError{Err: err}
+}
+
+// Invalidf formats a validation error.
+func Invalidf(format string, args ...interface{}) error {
+	return &ValidationError{Err: fmt.Errorf(format, args...)}
+}
+
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorInfo is a machine-readable description of an error.
+type ErrorInfo struct {
+	Code    string   `json:"code" yaml:"code"`
+	Message string   `json:"message" yaml:"message"`
+	Status  int      `json:"status,omitempty" yaml:"status,omitempty"`
+	Errors  []string `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// ExitCode returns a stable exit code for the error.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	return exitCodes[Describe(err).Code]
+}
+
+var exitCodes = map[string]int{
+	ErrCodeError:          ExitError,
+	ErrCodeValidation:     ExitValidation,
+	ErrCodeAuth:           ExitAuth,
+	ErrCodeServer:         ExitServer,
+	ErrCodeNotFound:       ExitNotFound,
+	ErrCodePartialFailure: ExitPartialFailure,
+}
+
+// Describe classifies the error and collects its messages.
+func Describe(err error) ErrorInfo {
+	var (
+		respErr  *jira.ErrUnexpectedResponse
+		multiErr *jira.ErrMultipleFailed
+		validErr *ValidationError
+		urlErr   *url.Error
+	)
+
+	switch {
+	case errors.As(err, &respErr):
+		info := ErrorInfo{
+			Code:    responseErrCode(respErr.StatusCode),
+			Message: respErr.Status,
+			Status:  respErr.StatusCode,
+			Errors:  append([]string{}, respErr.Body.ErrorMessages...),
+		}
+		keys := make([]string, 0, len(respErr.Body.Errors))
+		for k := range respErr.Body.Errors {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			info.Errors = append(info.Errors, fmt.Sprintf("%s: %s", k, respErr.Body.Errors[k]))
+		}
+		if len(info.Errors) == 0 {
+			info.Errors = nil
+		}
+		if info.Message == "" {
+			info.Message = http.StatusText(respErr.StatusCode)
+		}
+		return info
+	case errors.As(err, &multiErr):
+		info := ErrorInfo{Code: ErrCodePartialFailure, Message: "some requests reported error"}
+		for _, line := range strings.Split(multiErr.Error(), "\n") {
+			if line = strings.TrimPrefix(strings.TrimSpace(line), "- "); line != "" {
+				info.Errors = append(info.Errors, line)
+			}
+		}
+		return info
+	case errors.As(err, &validErr):
+		return ErrorInfo{Code: ErrCodeValidation, Message: validErr.Error()}
+	case errors.Is(err, jira.ErrNoResult):
+		return ErrorInfo{Code: ErrCodeNotFound, Message: err.Error()}
+	case errors.Is(err, jira.ErrEmptyResponse), errors.As(err, &urlErr):
+		return ErrorInfo{Code: ErrCodeServer, Message: err.Error()}
+	}
+	return ErrorInfo{Code: ErrCodeError, Message: err.Error()}
+}
+
+func responseErrCode(status int) string {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return ErrCodeAuth
+	case status == http.StatusNotFound:
+		return ErrCodeNotFound
+	case status == http.StatusBadRequest || status == http.StatusConflict || status == http.StatusUnprocessableEntity:
+		return ErrCodeValidation
+	case status >= http.StatusInternalServerError:
+		return ErrCodeServer
+	}
+	return ErrCodeError
+}
@@ -0,0 +1,113 @@
+package cmdutil
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		err      error
+		code     int
+		expected ErrorInfo
+	}{
+		{
+			name:     "it returns generic error for unknown errors",
+			err:      fmt.Errorf("oops"),
+			code:     ExitError,
+			expected: ErrorInfo{Code: ErrCodeError, Message: "oops"},
+		},
+		{
+			name: "it returns auth failure for unauthorized response",
+			err: &jira.ErrUnexpectedResponse{
+				Status:     "401 Unauthorized",
+				StatusCode: http.StatusUnauthorized,
+			},
+			code:     ExitAuth,
+			expected: ErrorInfo{Code: ErrCodeAuth, Message: "401 Unauthorized", Status: http.StatusUnauthorized},
+		},
+		{
+			name: "it returns not found for missing resources",
+			err: &jira.ErrUnexpectedResponse{
+				Body:       jira.Errors{ErrorMessages: []string{"Issue does not exist"}},
+				StatusCode: http.StatusNotFound,
+			},
+			code: ExitNotFound,
+			expected: ErrorInfo{
+				Code: ErrCodeNotFound, Message: "Not Found", Status: http.StatusNotFound,
+				Errors: []string{"Issue does not exist"},
+			},
+		},
+		{
+			name: "it returns validation failure for bad request",
+			err: fmt.Errorf("wrapped: %w", &jira.ErrUnexpectedResponse{
+				Body: jira.Errors{
+					ErrorMessages: []string{"Invalid request"},
+					Errors:        map[string]string{"summary": "required", "priority": "invalid"},
+				},
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+			}),
+			code: ExitValidation,
+			expected: ErrorInfo{
+				Code: ErrCodeValidation, Message: "400 Bad Request", Status: http.StatusBadRequest,
+				Errors: []string{"Invalid request", "priority: invalid", "summary: required"},
+			},
+		},
+		{
+			name:     "it returns server error for failed responses",
+			err:      &jira.ErrUnexpectedResponse{Status: "502 Bad Gateway", StatusCode: http.StatusBadGateway},
+			code:     ExitServer,
+			expected: ErrorInfo{Code: ErrCodeServer, Message: "502 Bad Gateway", Status: http.StatusBadGateway},
+		},
+		{
+			name:     "it returns server error if the server can't be reached",
+			err:      &url.Error{Op: "Get", URL: "https://jira.example", Err: fmt.Errorf("connection refused")},
+			code:     ExitServer,
+			expected: ErrorInfo{Code: ErrCodeServer, Message: `Get "https://jira.example": connection refused`},
+		},
+		{
+			name:     "it returns validation failure for invalid input",
+			err:      Invalidf("invalid state %q", "Nope"),
+			code:     ExitValidation,
+			expected: ErrorInfo{Code: ErrCodeValidation, Message: `invalid state "Nope"`},
+		},
+		{
+			name:     "it returns not found for no results",
+			err:      jira.ErrNoResult,
+			code:     ExitNotFound,
+			expected: ErrorInfo{Code: ErrCodeNotFound, Message: "jira: no result"},
+		},
+		{
+			name: "it returns partial failure for multiple failed requests",
+			err:  &jira.ErrMultipleFailed{Msg: "\n  - TEST-1: not allowed\n  - TEST-2: not found"},
+			code: ExitPartialFailure,
+			expected: ErrorInfo{
+				Code: ErrCodePartialFailure, Message: "some requests reported error",
+				Errors: []string{"TEST-1: not allowed", "TEST-2: not found"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.code, ExitCode(tc.err))
+			assert.Equal(t, tc.expected, Describe(tc.err))
+		})
+	}
+
+	assert.Equal(t, ExitOK, ExitCode(nil))
+}
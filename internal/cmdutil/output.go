@@ -0,0 +1,79 @@
+package cmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats accepted by the global --output flag.
+const (
+	OutputTable  = "table"
+	OutputJSON   = "json"
+	OutputYAML   = "yaml"
+	OutputNDJSON = "ndjson"
+)
+
+// OutputFormats returns the list of valid output formats.
+func OutputFormats() []string {
+	return []string{OutputJSON, OutputYAML, OutputNDJSON, OutputTable}
+}
+
+// OutputFormat returns the output format requested with the global
+// --output flag or JIRA_OUTPUT env. It defaults to the table format.
+func OutputFormat() string {
+	f := strings.ToLower(strings.TrimSpace(viper.GetString("output")))
+	if f == "" {
+		return OutputTable
+	}
+	return f
+}
+
+// IsStructuredOutput checks if a machine-readable output is requested.
+func IsStructuredOutput() bool {
+	return OutputFormat() != OutputTable
+}
+
+// ValidateOutputFormat makes sure the requested output format is supported.
+func ValidateOutputFormat() error {
+	f := OutputFormat()
+	for _, v := range OutputFormats() {
+		if f == v {
+			return nil
+		}
+	}
+	return Invalidf("invalid output format %q, expected one of: %s", f, strings.Join(OutputFormats(), ", "))
+}
+
+// Encode writes the value in the given structured format.
+func Encode(w io.Writer, format string, v interface{}) error {
+	switch format {
+	case OutputYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		return enc.Close()
+	case OutputNDJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		return enc.Encode(v)
+	default:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+		return enc.Encode(v)
+	}
+}
+
+// writeStructuredError writes the error in the requested output format.
+func writeStructuredError(w io.Writer, info ErrorInfo) {
+	if err := Encode(w, OutputFormat(), map[string]ErrorInfo{"error": info}); err != nil {
+		_, _ = fmt.Fprintf(w, "Error: %s\n", info.Message)
+	}
+}
@@ -20,11 +20,17 @@ import (
 )
 
 // ExitIfError exists with error message if err is not nil.
+// The exit code depends on the type of the error, see ExitCode.
 func ExitIfError(err error) {
 	if err == nil {
 		return
 	}
 
+	if IsStructuredOutput() {
+		writeStructuredError(os.Stderr, Describe(err))
+		os.Exit(ExitCode(err))
+	}
+
 	var msg string
 
 	if e, ok := err.(*jira.ErrUnexpectedResponse); ok {
@@ -50,7 +56,7 @@ func ExitIfError(err error) {
 	}
 
 	fmt.Fprintf(os.Stderr, "%s\n", msg)
-	os.Exit(1)
+	os.Exit(ExitCode(err))
 }
 
 // Info displays spinner.
@@ -86,8 +92,24 @@ func Fail(msg string, args ...interface{}) {
 
 // Failed prints failure message in stderr and exits.
 func Failed(msg string, args ...interface{}) {
-	Fail(msg, args...)
-	os.Exit(1)
+	FailedWithCode(ExitError, msg, args...)
+}
+
+// FailedWithCode prints failure message in stderr and exits with the given code.
+func FailedWithCode(code int, msg string, args ...interface{}) {
+	if IsStructuredOutput() {
+		m := strings.TrimSpace(fmt.Sprintf(msg, args...))
+		info := ErrorInfo{Code: ErrCodeError, Message: strings.TrimPrefix(m, "Error: ")}
+		for c, ec := range exitCodes {
+			if ec == code {
+				info.Code = c
+			}
+		}
+		writeStructuredError(os.Stderr, info)
+	} else {
+		Fail(msg, args...)
+	}
+	os.Exit(code)
 }
 
 // Navigate navigates to jira issue.
@@ -115,6 +137,9 @@ func FormatDateTimeHuman(dt, format string) string {
 	return t.Format("Mon, 02 Jan 06")
 }
 
+// ConfigDir is the jira-cli directory in the config home, see GetConfigHome.
+const ConfigDir = ".jira"
+
 // GetConfigHome returns the config home directory.
 func GetConfigHome() (string, error) {
 	home := os.Getenv("XDG_CONFIG_HOME")
@@ -210,9 +235,29 @@ func GetTUIStyleConfig() tui.TableStyle {
 		bold = viper.GetBool("tui.selection.bold")
 	}
 
+	// The auto theme is left to the UI, so that the terminal is only asked
+	// for its background color when the interactive UI is shown.
+	var theme tui.Theme
+	if name := viper.GetString("tui.theme"); name != "" && name != tui.ThemeAuto {
+		t, err := tui.LookupTheme(name)
+		if err != nil {
+			Warn("Invalid tui.theme: %s, using the auto theme", err)
+		}
+		theme = t
+	}
+
+	keymap, err := tui.NewKeymap(viper.GetString("tui.keymap"), viper.GetStringMapString("tui.keys"))
+	if err != nil {
+		Warn("Invalid key bindings: %s, using the default keys", err)
+		keymap = tui.DefaultKeymap()
+	}
+
 	return tui.TableStyle{
 		SelectionBackground: viper.GetString("tui.selection.background"),
 		SelectionForeground: viper.GetString("tui.selection.foreground"),
 		SelectionTextIsBold: bold,
+		Split:               strings.EqualFold(viper.GetString("tui.layout"), "split"),
+		Theme:               theme,
+		Keymap:              keymap,
 	}
 }
@@ -0,0 +1,257 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	shellquote "github.com/kballard/go-shellquote"
+	"github.com/spf13/viper"
+)
+
+// AliasesKey is a config key under which the user defined aliases are saved.
+const AliasesKey = "aliases"
+
+// ShellAliasPrefix marks an alias that is run with the shell instead of jira.
+const ShellAliasPrefix = "!"
+
+var validAliasName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+// ValidateAliasName makes sure the name can be used as a config key and as a command.
+func ValidateAliasName(name string) error {
+	if !validAliasName.MatchString(name) {
+		return fmt.Errorf(
+			"invalid alias name %q: use letters, numbers, dashes and underscores only, starting with a letter or a number", name,
+		)
+	}
+	return nil
+}
+
+// IsShellAlias checks if the expansion is a shell alias.
+func IsShellAlias(expansion string) bool {
+	return strings.HasPrefix(expansion, ShellAliasPrefix)
+}
+
+// Alias returns the expansion of the alias with the given name.
+// The names are case-insensitive like all config keys.
+func Alias(name string) (string, bool) {
+	if ValidateAliasName(name) != nil {
+		return "", false
+	}
+	a := strings.TrimSpace(viper.GetString(AliasesKey + "." + strings.ToLower(name)))
+	return a, a != ""
+}
+
+// Aliases returns all aliases in the loaded config.
+func Aliases() map[string]string {
+	return nonEmpty(viper.GetStringMapString(AliasesKey))
+}
+
+// LoadAliases reads the aliases from the given config file. It is used
+// to expand the aliases before the command line is parsed, ie: before
+// the config is loaded. A missing config file has no aliases.
+func LoadAliases(path string) (map[string]string, error) {
+	if !Exists(path) {
+		return map[string]string{}, nil
+	}
+	file, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return nonEmpty(file.v.GetStringMapString(AliasesKey)), nil
+}
+
+// SaveAlias saves the alias with the given name in the user config file.
+// It returns the path of the config file.
+func SaveAlias(name, expansion string) (string, error) {
+	if err := ValidateAliasName(name); err != nil {
+		return "", err
+	}
+	expansion = strings.TrimSpace(expansion)
+	if expansion == "" || expansion == ShellAliasPrefix {
+		return "", fmt.Errorf("unable to save an empty alias")
+	}
+
+	return setUserKey(AliasesKey+"."+strings.ToLower(name), expansion)
+}
+
+// DeleteAlias removes the alias from the user config file.
+// It returns false if there is no such alias.
+func DeleteAlias(name string) (bool, error) {
+	if err := ValidateAliasName(name); err != nil {
+		return false, err
+	}
+
+	return unsetUserKey(AliasesKey + "." + strings.ToLower(name))
+}
+
+// ExpandAlias expands a jira alias with the given arguments
+// and returns the arguments to run the command with.
+//
+// Positional parameters $1, $2, ... are replaced with the arguments and the
+// arguments that are not referenced are appended, so that the flags can still
+// be passed to the aliased command. Command substitutions $(...) are run with
+// the given function. Like in a shell, nothing is expanded in single quotes and
+// the expanded values are never split into words.
+func ExpandAlias(expansion string, args []string, run func(string) (string, error)) ([]string, error) {
+	var (
+		b    strings.Builder
+		used = make(map[int]bool)
+		// quote is the quote the current position is in, 0 if none.
+		quote rune
+	)
+
+	rs := []rune(expansion)
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			}
+			b.WriteRune(r)
+			continue
+		case r == '\\' && i+1 < len(rs):
+			b.WriteRune(r)
+			b.WriteRune(rs[i+1])
+			i++
+			continue
+		case r == '"' || (r == '\'' && quote == 0):
+			if quote == r {
+				quote = 0
+			} else if quote == 0 {
+				quote = r
+			}
+			b.WriteRune(r)
+			continue
+		case r != '$' || i+1 == len(rs):
+			b.WriteRune(r)
+			continue
+		}
+
+		var val string
+		switch next := rs[i+1]; {
+		case next >= '0' && next <= '9':
+			j := i + 1
+			for j < len(rs) && rs[j] >= '0' && rs[j] <= '9' {
+				j++
+			}
+			n, _ := strconv.Atoi(string(rs[i+1 : j]))
+			if n == 0 {
+				b.WriteString(string(rs[i:j]))
+				i = j - 1
+				continue
+			}
+			if n > len(args) {
+				return nil, fmt.Errorf("not enough arguments: $%d is not set", n)
+			}
+			val, used[n-1] = args[n-1], true
+			i = j - 1
+		case next == '(':
+			end := closingParen(rs, i+1)
+			if end < 0 {
+				return nil, fmt.Errorf("missing closing parenthesis in %q", string(rs[i:]))
+			}
+			command := string(rs[i+2 : end])
+			out, err := run(command)
+			if err != nil {
+				return nil, fmt.Errorf("command substitution $(%s) failed: %w", command, err)
+			}
+			val = strings.TrimRight(out, "\r\n")
+			i = end
+		default:
+			b.WriteRune(r)
+			continue
+		}
+
+		if quote == '"' {
+			b.WriteString(escapeDoubleQuoted(val))
+		} else {
+			b.WriteString(shellquote.Join(val))
+		}
+	}
+
+	out, err := shellquote.Split(b.String())
+	if err != nil {
+		return nil, err
+	}
+	for i, a := range args {
+		if !used[i] {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+// ShellAliasArgs returns the arguments for `sh` to run a shell alias with.
+// The arguments are available in the alias as $1, $2, ... and $@.
+func ShellAliasArgs(expansion string, args []string) []string {
+	script := strings.TrimPrefix(expansion, ShellAliasPrefix)
+	return append([]string{"-c", script, "--"}, args...)
+}
+
+// ConfigFlag returns the value of the config flag in the command line arguments.
+// Only the -c, -c<value>, --config and --config=<value> forms are matched, and
+// the arguments after -- are not scanned, since they are not flags.
+func ConfigFlag(args []string) (string, bool) {
+	for i, a := range args {
+		switch {
+		case a == "--":
+			return "", false
+		case a == "-c" || a == "--config":
+			if i+1 < len(args) {
+				return args[i+1], true
+			}
+			return "", false
+		case strings.HasPrefix(a, "--config="):
+			return strings.TrimPrefix(a, "--config="), true
+		case strings.HasPrefix(a, "-c") && !strings.HasPrefix(a, "--"):
+			// The shorthand value may be separated with =, eg: -c=file.yml.
+			return strings.TrimPrefix(a[2:], "="), true
+		}
+	}
+	return "", false
+}
+
+// AliasNames returns the names of the aliases in order.
+func AliasNames(aliases map[string]string) []string {
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func closingParen(rs []rune, start int) int {
+	depth := 0
+	for i := start; i < len(rs); i++ {
+		switch rs[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func escapeDoubleQuoted(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "`", "\\`").Replace(s)
+}
+
+func nonEmpty(m map[string]string) map[string]string {
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		if v = strings.TrimSpace(v); v != "" {
+			out[k] = v
+		}
+	}
+	return out
+}
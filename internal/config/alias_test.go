@@ -0,0 +1,230 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAliasName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		valid bool
+	}{
+		{name: "mine", valid: true},
+		{name: "in-progress_2", valid: true},
+		{name: "", valid: false},
+		{name: "-mine", valid: false},
+		{name: "my issues", valid: false},
+		{name: "my.issues", valid: false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateAliasName(tc.name)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestExpandAlias(t *testing.T) {
+	t.Parallel()
+
+	run := func(cmd string) (string, error) {
+		switch cmd {
+		case "jira me":
+			return "john@example.com\n", nil
+		case "echo 'a b'":
+			return "a b\n", nil
+		}
+		return "", fmt.Errorf("exit status 1")
+	}
+
+	cases := []struct {
+		name      string
+		expansion string
+		args      []string
+		expected  []string
+		err       string
+	}{
+		{
+			name:      "plain",
+			expansion: "issue list --plain",
+			args:      []string{"--columns", "key"},
+			expected:  []string{"issue", "list", "--plain", "--columns", "key"},
+		},
+		{
+			name:      "quoted values",
+			expansion: `issue list -s"In Progress" -l 'needs review'`,
+			expected:  []string{"issue", "list", "-sIn Progress", "-l", "needs review"},
+		},
+		{
+			name:      "positional parameters",
+			expansion: "issue move $1 $2",
+			args:      []string{"ISSUE-1", "In Progress", "--comment", "done"},
+			expected:  []string{"issue", "move", "ISSUE-1", "In Progress", "--comment", "done"},
+		},
+		{
+			name:      "parameter used twice and in a flag",
+			expansion: `issue list -a$1 -r"$1"`,
+			args:      []string{"John Doe"},
+			expected:  []string{"issue", "list", "-aJohn Doe", "-rJohn Doe"},
+		},
+		{
+			name:      "parameter in double quotes is escaped",
+			expansion: `issue comment add ISSUE-1 "$1"`,
+			args:      []string{`say "hi" for $5`},
+			expected:  []string{"issue", "comment", "add", "ISSUE-1", `say "hi" for $5`},
+		},
+		{
+			name:      "empty parameter",
+			expansion: "issue list -q $1",
+			args:      []string{""},
+			expected:  []string{"issue", "list", "-q", ""},
+		},
+		{
+			name:      "nothing is expanded in single quotes",
+			expansion: `issue list -q '$1 $(jira me)'`,
+			args:      []string{"x"},
+			expected:  []string{"issue", "list", "-q", "$1 $(jira me)", "x"},
+		},
+		{
+			name:      "escaped dollar",
+			expansion: `issue list -q \$1`,
+			args:      []string{"x"},
+			expected:  []string{"issue", "list", "-q", "$1", "x"},
+		},
+		{
+			name:      "command substitution",
+			expansion: `issue list -s"In Progress" -a$(jira me)`,
+			expected:  []string{"issue", "list", "-sIn Progress", "-ajohn@example.com"},
+		},
+		{
+			name:      "command substitution is not split",
+			expansion: `issue list -l $(echo 'a b')`,
+			expected:  []string{"issue", "list", "-l", "a b"},
+		},
+		{
+			name:      "not enough arguments",
+			expansion: "issue move $1 $2",
+			args:      []string{"ISSUE-1"},
+			err:       "not enough arguments: $2 is not set",
+		},
+		{
+			name:      "failed command substitution",
+			expansion: "issue list -a$(whoami)",
+			err:       "command substitution $(whoami) failed: exit status 1",
+		},
+		{
+			name:      "unclosed command substitution",
+			expansion: "issue list -a$(jira me",
+			err:       `missing closing parenthesis in "$(jira me"`,
+		},
+		{
+			name:      "unclosed quote",
+			expansion: `issue list -s"In Progress`,
+			err:       "Unterminated double-quoted string",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ExpandAlias(tc.expansion, tc.args, run)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestShellAliasArgs(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsShellAlias(`!jira issue list "$@" | wc -l`))
+	assert.False(t, IsShellAlias("issue list"))
+	assert.Equal(
+		t,
+		[]string{"-c", `jira issue list "$@" | wc -l`, "--", "-s", "Done"},
+		ShellAliasArgs(`!jira issue list "$@" | wc -l`, []string{"-s", "Done"}),
+	)
+}
+
+func TestConfigFlag(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		args     []string
+		expected string
+		found    bool
+	}{
+		{name: "shorthand", args: []string{"wip", "-c", "a.yml"}, expected: "a.yml", found: true},
+		{name: "shorthand with value", args: []string{"-ca.yml", "wip"}, expected: "a.yml", found: true},
+		{name: "shorthand with equals", args: []string{"-c=a.yml", "wip"}, expected: "a.yml", found: true},
+		{name: "long", args: []string{"--config", "a.yml", "wip"}, expected: "a.yml", found: true},
+		{name: "long with equals", args: []string{"wip", "--config=a.yml"}, expected: "a.yml", found: true},
+		{name: "no flag", args: []string{"wip", "--comment", "x"}, found: false},
+		{name: "similar long flag", args: []string{"wip", "--configure", "x", "--config-file=y"}, found: false},
+		{name: "missing value", args: []string{"wip", "--config"}, found: false},
+		{name: "after double dash", args: []string{"wip", "--", "-c", "a.yml"}, found: false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, ok := ConfigFlag(tc.args)
+			assert.Equal(t, tc.found, ok)
+			assert.Equal(t, tc.expected, f)
+		})
+	}
+}
+
+func TestLoadAliases(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), ".config.yml")
+
+	aliases, err := LoadAliases(path)
+	assert.NoError(t, err)
+	assert.Empty(t, aliases)
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+server: https://jira.example.com
+aliases:
+  mine: issue list -a$(jira me)
+  Count: '!jira issue list --plain | wc -l'
+  empty: ""
+`), 0o600))
+
+	aliases, err = LoadAliases(path)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"mine":  "issue list -a$(jira me)",
+		"count": "!jira issue list --plain | wc -l",
+	}, aliases)
+	assert.Equal(t, []string{"count", "mine"}, AliasNames(aliases))
+}
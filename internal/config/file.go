@@ -0,0 +1,114 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+// File is a config file that can be read and updated key by key.
+type File struct {
+	path string
+	v    *viper.Viper
+}
+
+// DefaultFile returns the path to the user config file in use,
+// or the default location if no config file was loaded.
+func DefaultFile() (string, error) {
+	if f := viper.ConfigFileUsed(); f != "" {
+		return f, nil
+	}
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s/%s.%s", home, Dir, FileName, FileType), nil
+}
+
+// SaveLogin saves the login to the user config file.
+func SaveLogin(login string) error {
+	_, err := setUserKey("login", login)
+	return err
+}
+
+// Load reads the config file from the given path.
+func Load(path string) (*File, error) {
+	f := File{path: path, v: newFileViper(path)}
+	if err := f.v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Path returns the path of the config file.
+func (f *File) Path() string {
+	return f.path
+}
+
+// Get returns the value of the key, nil if the key is not set.
+func (f *File) Get(key string) interface{} {
+	return f.v.Get(key)
+}
+
+// UnmarshalKey decodes the value of the key into the given struct.
+func (f *File) UnmarshalKey(key string, out interface{}) error {
+	return f.v.UnmarshalKey(key, out)
+}
+
+// IsSet checks if the key is set in the config file.
+func (f *File) IsSet(key string) bool {
+	return f.v.IsSet(key)
+}
+
+// Set sets the value of the key.
+func (f *File) Set(key string, val interface{}) {
+	f.v.Set(key, val)
+}
+
+// Unset removes the key from the config. It returns false if the key is not set.
+func (f *File) Unset(key string) bool {
+	settings := f.v.AllSettings()
+
+	parts := strings.Split(strings.ToLower(key), ".")
+	m := settings
+	for _, p := range parts[:len(parts)-1] {
+		sub, ok := m[p].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		m = sub
+	}
+	last := parts[len(parts)-1]
+	if _, ok := m[last]; !ok {
+		return false
+	}
+	delete(m, last)
+
+	v := newFileViper(f.path)
+	if err := v.MergeConfigMap(settings); err != nil {
+		return false
+	}
+	f.v = v
+
+	return true
+}
+
+// Settings returns all settings in the config file.
+func (f *File) Settings() map[string]interface{} {
+	return f.v.AllSettings()
+}
+
+// Save writes the config back to the file.
+func (f *File) Save() error {
+	return f.v.WriteConfigAs(f.path)
+}
+
+func newFileViper(path string) *viper.Viper {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType(FileType)
+	return v
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), ".config.yml")
+	content := `server: https://test.atlassian.net
+project:
+  key: TEST
+  type: classic
+`
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	file, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST", file.Get("project.key"))
+
+	file.Set("issue.defaults.labels", []string{"backend"})
+	assert.True(t, file.Unset("project.type"))
+	assert.False(t, file.Unset("project.type"))
+	assert.False(t, file.Unset("board.id"))
+	assert.NoError(t, file.Save())
+
+	file, err = Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST", file.Get("project.key"))
+	assert.False(t, file.IsSet("project.type"))
+	assert.Equal(t, []interface{}{"backend"}, file.Get("issue.defaults.labels"))
+}
@@ -2,10 +2,9 @@ package config
 
 import (
 	"fmt"
-	"net/url"
 	"os"
 	"path/filepath"
-	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -19,7 +18,7 @@ import (
 
 const (
 	// Dir is a jira-cli config directory.
-	Dir = ".jira"
+	Dir = cmdutil.ConfigDir
 	// FileName is a jira-cli config file name.
 	FileName = ".config"
 	// FileType is a jira-cli config file extension.
@@ -63,15 +62,16 @@ type JiraCLIMTLSConfig struct {
 
 // JiraCLIConfig is a Jira CLI config.
 type JiraCLIConfig struct {
-	Installation string
-	Server       string
-	AuthType     string
-	Login        string
-	Project      string
-	Board        string
-	Force        bool
-	Insecure     bool
-	MTLS         JiraCLIMTLSConfig
+	Installation   string
+	Server         string
+	AuthType       string
+	Login          string
+	Project        string
+	Board          string
+	Force          bool
+	Insecure       bool
+	NonInteractive bool
+	MTLS           JiraCLIMTLSConfig
 }
 
 // JiraCLIConfigGenerator is a Jira CLI config generator.
@@ -148,8 +148,21 @@ func (c *JiraCLIConfigGenerator) Generate() (string, error) {
 		return Exists(cfgFile)
 	}()
 
-	if !c.usrCfg.Force && cfgExists && !shallOverwrite() {
-		return "", ErrSkip
+	if !c.usrCfg.Force && cfgExists {
+		if c.usrCfg.NonInteractive {
+			return "", &InitError{
+				Code:    InitErrConfigExists,
+				Message: fmt.Sprintf("config already exists at %s, use --force to overwrite", cfgFile),
+			}
+		}
+		if !shallOverwrite() {
+			return "", ErrSkip
+		}
+	}
+	if c.usrCfg.NonInteractive {
+		if err := c.checkNonInteractiveParams(); err != nil {
+			return "", err
+		}
 	}
 	if err := c.configureInstallationType(); err != nil {
 		return "", err
@@ -172,19 +185,19 @@ func (c *JiraCLIConfigGenerator) Generate() (string, error) {
 	}
 
 	if err := c.configureServerAndLoginDetails(); err != nil {
-		return "", err
+		return "", c.wrapErr(err, InitErrAuth, "login")
 	}
 
 	if c.value.installation == jira.InstallationTypeLocal {
 		if err := c.configureServerMeta(c.value.server, c.value.login); err != nil {
-			return "", err
+			return "", c.wrapErr(err, InitErrServer, "server")
 		}
 	}
 	if err := c.configureProjectAndBoardDetails(); err != nil {
-		return "", err
+		return "", c.wrapErr(err, InitErrNotFound, "")
 	}
 	if err := c.configureMetadata(); err != nil {
-		return "", err
+		return "", c.wrapErr(err, InitErrMetadata, "")
 	}
 
 	if err := func() error {
@@ -226,7 +239,7 @@ func (c *JiraCLIConfigGenerator) configureInstallationType() error {
 func (c *JiraCLIConfigGenerator) configureLocalAuthType() error {
 	authType := c.usrCfg.AuthType
 
-	if c.usrCfg.AuthType == "" {
+	if c.usrCfg.AuthType == "" && !c.usrCfg.NonInteractive {
 		qs := &survey.Select{
 			Message: "Authentication type:",
 			Help: `Authentication type coud be: basic (login), bearer (PAT) or mtls (client certs)
@@ -315,23 +328,7 @@ func (c *JiraCLIConfigGenerator) configureServerAndLoginDetails() error {
 				Message: "Link to Jira server:",
 				Help:    "This is a link to your jira server, eg: https://company.atlassian.net",
 			},
-			Validate: func(val interface{}) error {
-				errInvalidURL := fmt.Errorf("not a valid URL")
-
-				str, ok := val.(string)
-				if !ok {
-					return errInvalidURL
-				}
-				u, err := url.Parse(str)
-				if err != nil || u.Scheme == "" || u.Host == "" {
-					return errInvalidURL
-				}
-				if u.Scheme != "http" && u.Scheme != "https" {
-					return errInvalidURL
-				}
-
-				return nil
-			},
+			Validate: validateServerURL,
 		})
 	}
 
@@ -344,27 +341,7 @@ func (c *JiraCLIConfigGenerator) configureServerAndLoginDetails() error {
 					Message: "Login email:",
 					Help:    "This is the email you use to login to your jira account.",
 				},
-				Validate: func(val interface{}) error {
-					var (
-						emailRegex = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+\\/=?^_`{|}~-]+@[a-zA-Z0-9]" +
-							"(?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
-
-						errInvalidEmail = fmt.Errorf("not a valid email")
-					)
-
-					str, ok := val.(string)
-					if !ok {
-						return errInvalidEmail
-					}
-					if len(str) < 3 || len(str) > 254 {
-						return errInvalidEmail
-					}
-					if !emailRegex.MatchString(str) {
-						return errInvalidEmail
-					}
-
-					return nil
-				},
+				Validate: validateEmail,
 			})
 		case jira.InstallationTypeLocal:
 			qs = append(qs, &survey.Question{
@@ -373,19 +350,7 @@ func (c *JiraCLIConfigGenerator) configureServerAndLoginDetails() error {
 					Message: "Login username:",
 					Help:    "This is the username you use to login to your jira account.",
 				},
-				Validate: func(val interface{}) error {
-					errInvalidUser := fmt.Errorf("not a valid user")
-
-					str, ok := val.(string)
-					if !ok {
-						return errInvalidUser
-					}
-					if len(str) < 2 || len(str) > 254 {
-						return errInvalidUser
-					}
-
-					return nil
-				},
+				Validate: validateUsername,
 			})
 		}
 	}
@@ -433,8 +398,13 @@ func (c *JiraCLIConfigGenerator) verifyLoginDetails(server, login string) error
 	if err != nil {
 		return err
 	}
-	if c.value.authType == jira.AuthTypeBearer {
+	switch c.value.authType {
+	case jira.AuthTypeBearer:
 		login = ret.Login
+	case jira.AuthTypeOAuth2:
+		if login == "" {
+			login = ret.Email
+		}
 	}
 
 	c.value.server = server
@@ -506,7 +476,10 @@ func (c *JiraCLIConfigGenerator) configureProjectAndBoardDetails() error {
 	}
 	defaultBoardSuggestions := c.boardSuggestions
 
-	if c.usrCfg.Board == "" {
+	if c.usrCfg.Board == "" && c.usrCfg.NonInteractive {
+		board = optionNone
+	}
+	if c.usrCfg.Board == "" && !c.usrCfg.NonInteractive {
 		for {
 			boardPrompt := &survey.Question{
 				Name: "",
@@ -550,7 +523,7 @@ func (c *JiraCLIConfigGenerator) configureProjectAndBoardDetails() error {
 			}
 		}
 	}
-	c.value.board = c.boardsMap[strings.ToLower(board)]
+	c.value.board = c.findBoard(project, board)
 
 	if c.value.board == nil && !strings.EqualFold(board, optionNone) {
 		var suggest string
@@ -568,6 +541,32 @@ func (c *JiraCLIConfigGenerator) configureProjectAndBoardDetails() error {
 	return nil
 }
 
+// findBoard finds the board by name or ID. It fetches the board by ID, or searches
+// the boards by name, if the board is not in the default suggestions.
+func (c *JiraCLIConfigGenerator) findBoard(project, board string) *jira.Board {
+	if b, ok := c.boardsMap[strings.ToLower(board)]; ok {
+		return b
+	}
+	if id, err := strconv.Atoi(board); err == nil {
+		for _, b := range c.boardsMap {
+			if b.ID == id {
+				return b
+			}
+		}
+		if b, err := c.jiraClient.GetBoard(id); err == nil {
+			c.boardsMap[strings.ToLower(b.Name)] = b
+			return b
+		}
+	}
+	if strings.EqualFold(board, optionNone) || len(board) < 3 {
+		return nil
+	}
+	if err := c.searchAndAssignBoard(project, board); err != nil {
+		return nil
+	}
+	return c.boardsMap[strings.ToLower(board)]
+}
+
 func (*JiraCLIConfigGenerator) getSearchKeyword() (string, error) {
 	var ans string
 
@@ -768,6 +767,13 @@ func (c *JiraCLIConfigGenerator) write(path string) (string, error) {
 		config.Set("mtls.client_key", c.value.mtls.clientKey)
 	}
 
+	// OAuth 2.0 client ID may come from the env during init.
+	if c.value.authType == jira.AuthTypeOAuth2 {
+		if id := api.OAuth2Config().ClientID; id != "" {
+			config.Set("oauth.client_id", id)
+		}
+	}
+
 	// Jira version.
 	if c.value.version.major > 0 {
 		config.Set("version.major", c.value.version.major)
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// ViewsKey is a config key under which the layouts of the interactive tables are saved per command.
+const ViewsKey = "tui.views"
+
+// TableLayout returns the saved layout of the table for the given view, eg: issue_list.
+func TableLayout(view string) tui.TableLayout {
+	var layout tui.TableLayout
+	if err := viper.UnmarshalKey(ViewsKey+"."+view, &layout); err != nil {
+		return tui.TableLayout{}
+	}
+	return layout
+}
+
+// SaveTableLayout saves the layout of the table for the given view in the user config file.
+func SaveTableLayout(view string, layout tui.TableLayout) error {
+	key := ViewsKey + "." + view
+
+	if layout.IsZero() {
+		_, err := unsetUserKey(key)
+		viper.Set(key, nil)
+		return err
+	}
+
+	settings := make(map[string]interface{})
+	if layout.Sort != "" {
+		settings["sort"] = strings.ToLower(layout.Sort)
+		settings["order"] = string(layout.Order)
+	}
+	if len(layout.Hidden) > 0 {
+		settings["hidden"] = layout.Hidden
+	}
+	if len(layout.Widths) > 0 {
+		widths := make(map[string]interface{}, len(layout.Widths))
+		for k, v := range layout.Widths {
+			widths[strings.ToLower(k)] = v
+		}
+		settings["widths"] = widths
+	}
+
+	_, err := setUserKey(key, settings)
+	return err
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+func TestSaveTableLayout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".config.yml")
+	require.NoError(t, os.WriteFile(path, []byte("server: https://example.atlassian.net\n"), 0o600))
+
+	viper.Reset()
+	viper.SetConfigFile(path)
+	require.NoError(t, viper.ReadInConfig())
+	t.Cleanup(viper.Reset)
+
+	layout := tui.TableLayout{
+		Sort:   "created",
+		Order:  tui.SortDesc,
+		Hidden: []string{"reporter", "labels"},
+		Widths: map[string]int{"summary": 40},
+	}
+	require.NoError(t, SaveTableLayout("issue_list", layout))
+	assert.Equal(t, layout, TableLayout("issue_list"))
+
+	file, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.atlassian.net", file.Get("server"))
+	assert.Equal(t, "created", file.Get("tui.views.issue_list.sort"))
+	assert.Empty(t, Validate(file.Settings()))
+
+	require.NoError(t, SaveTableLayout("issue_list", tui.TableLayout{}))
+	assert.Equal(t, tui.TableLayout{}, TableLayout("issue_list"))
+
+	file, err = Load(path)
+	require.NoError(t, err)
+	assert.False(t, file.IsSet("tui.views.issue_list"))
+}
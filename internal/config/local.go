@@ -0,0 +1,120 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/spf13/viper"
+)
+
+// LocalFileName is a per-repository config file name.
+const LocalFileName = ".jira.yml"
+
+// restrictedLocalKeys are the keys that can only be defined in the user config.
+// A repository config is usually committed and shared, so it must never
+// be able to redirect requests, define credentials or run commands.
+var restrictedLocalKeys = []string{
+	"server",
+	"browse_server",
+	"login",
+	"api_token",
+	"credential_helper",
+	"auth_type",
+	"installation",
+	"insecure",
+	"mtls",
+	"oauth",
+	"version",
+	"aliases",
+}
+
+// FindLocal searches for a per-repository config file starting
+// from the given directory and walking up to the filesystem root.
+// It returns an empty string if no file is found.
+func FindLocal(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+
+	for {
+		file := filepath.Join(dir, LocalFileName)
+		if info, err := os.Stat(file); err == nil && !info.IsDir() {
+			return file
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// MergeLocal merges the per-repository config file over the config loaded in v.
+// Keys that are restricted to the user config are skipped and returned
+// so that the caller can report them.
+func MergeLocal(v *viper.Viper, file string) ([]string, error) {
+	local := viper.New()
+	local.SetConfigFile(file)
+	local.SetConfigType(FileType)
+
+	if err := local.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	settings := local.AllSettings()
+
+	var skipped []string
+	for k := range settings {
+		if slices.Contains(restrictedLocalKeys, k) {
+			skipped = append(skipped, k)
+			delete(settings, k)
+		}
+	}
+	sort.Strings(skipped)
+
+	resolveLocalTemplate(settings, file)
+
+	return skipped, v.MergeConfigMap(settings)
+}
+
+// resolveLocalTemplate resolves the template path in the per-repository
+// config settings, which is relative to the config file.
+func resolveLocalTemplate(settings map[string]interface{}, file string) {
+	issue, ok := settings["issue"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	defaults, ok := issue["defaults"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	if tmpl, ok := defaults["template"].(string); ok && tmpl != "" && tmpl != "-" && !filepath.IsAbs(tmpl) {
+		defaults["template"] = filepath.Join(filepath.Dir(file), tmpl)
+	}
+}
+
+// ValidateLocal validates the settings of the given per-repository config file. In addition
+// to the schema checks, it reports keys that are restricted to the user config.
+func ValidateLocal(file string, settings map[string]interface{}) []Problem {
+	var problems []Problem
+
+	resolveLocalTemplate(settings, file)
+
+	for k := range settings {
+		if slices.Contains(restrictedLocalKeys, k) {
+			problems = append(problems, Problem{Key: k, Msg: "key is restricted to the user config and will be ignored"})
+			delete(settings, k)
+		}
+	}
+	problems = append(problems, Validate(settings)...)
+
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].Key < problems[j].Key
+	})
+
+	return problems
+}
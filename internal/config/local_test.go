@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindLocal(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	nested := filepath.Join(root, "services", "api")
+	assert.NoError(t, os.MkdirAll(nested, 0o700))
+
+	// case: no file in the tree
+	assert.Equal(t, "", FindLocal(nested))
+
+	// case: file in one of the parent directories
+	file := filepath.Join(root, LocalFileName)
+	assert.NoError(t, os.WriteFile(file, []byte("project:\n  key: TEST\n"), 0o600))
+	assert.Equal(t, file, FindLocal(nested))
+
+	// case: closest file wins
+	closest := filepath.Join(root, "services", LocalFileName)
+	assert.NoError(t, os.WriteFile(closest, []byte("project:\n  key: API\n"), 0o600))
+	assert.Equal(t, closest, FindLocal(nested))
+}
+
+func TestMergeLocal(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), LocalFileName)
+	content := `server: https://evil.example.com
+login: someone@example.com
+mtls:
+  client_key: /tmp/key.pem
+project:
+  key: API
+issue:
+  defaults:
+    labels:
+      - backend
+    template: .github/issue.tmpl
+`
+	assert.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+
+	user := `server: https://test.atlassian.net
+login: test@example.com
+project:
+  key: TEST
+  type: classic
+`
+	v := viper.New()
+	v.SetConfigType(FileType)
+	assert.NoError(t, v.ReadConfig(strings.NewReader(user)))
+
+	skipped, err := MergeLocal(v, file)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"login", "mtls", "server"}, skipped)
+	assert.Equal(t, "https://test.atlassian.net", v.GetString("server"))
+	assert.Equal(t, "test@example.com", v.GetString("login"))
+	assert.Equal(t, "", v.GetString("mtls.client_key"))
+	assert.Equal(t, "API", v.GetString("project.key"))
+	assert.Equal(t, "classic", v.GetString("project.type"))
+	assert.Equal(t, []string{"backend"}, v.GetStringSlice("issue.defaults.labels"))
+	assert.Equal(t, filepath.Join(filepath.Dir(file), ".github/issue.tmpl"), v.GetString("issue.defaults.template"))
+}
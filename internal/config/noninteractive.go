@@ -0,0 +1,227 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Non-interactive init error codes.
+const (
+	// InitErrMissingValue is returned if a required value is not provided.
+	InitErrMissingValue = "missing_value"
+	// InitErrInvalidValue is returned if a provided value is invalid.
+	InitErrInvalidValue = "invalid_value"
+	// InitErrConfigExists is returned if the config exists and overwrite is not forced.
+	InitErrConfigExists = "config_exists"
+	// InitErrAuth is returned if the login details can't be verified.
+	InitErrAuth = "auth_failed"
+	// InitErrServer is returned if the server can't be reached or queried.
+	InitErrServer = "server_error"
+	// InitErrNotFound is returned if the project or board can't be resolved.
+	InitErrNotFound = "not_found"
+	// InitErrMetadata is returned if the metadata discovery fails.
+	InitErrMetadata = "metadata_failed"
+)
+
+// InitError is a machine-readable error returned by the config
+// generator when running in a non-interactive mode.
+type InitError struct {
+	Code    string `json:"code"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e *InitError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return e.Message
+}
+
+// ExitCode returns a stable exit code for the error.
+func (e *InitError) ExitCode() int {
+	switch e.Code {
+	case InitErrMissingValue, InitErrInvalidValue:
+		return 2
+	case InitErrConfigExists:
+		return 3
+	case InitErrAuth:
+		return 4
+	case InitErrServer:
+		return 5
+	case InitErrNotFound:
+		return 6
+	case InitErrMetadata:
+		return 7
+	default:
+		return 1
+	}
+}
+
+// checkNonInteractiveParams makes sure that every value that would
+// otherwise be prompted is provided and valid.
+//
+//nolint:gocyclo
+func (c *JiraCLIConfigGenerator) checkNonInteractiveParams() error {
+	missing := func(field string) error {
+		return &InitError{Code: InitErrMissingValue, Field: field, Message: "value is required in non-interactive mode"}
+	}
+	invalid := func(field string, err error) error {
+		return &InitError{Code: InitErrInvalidValue, Field: field, Message: err.Error()}
+	}
+
+	cfg := c.usrCfg
+
+	switch cfg.Installation {
+	case "":
+		return missing("installation")
+	case strings.ToLower(jira.InstallationTypeCloud), strings.ToLower(jira.InstallationTypeLocal):
+	default:
+		return invalid("installation", fmt.Errorf("expected one of: cloud, local"))
+	}
+
+	switch jira.AuthType(cfg.AuthType) {
+	case "", jira.AuthTypeBasic, jira.AuthTypeBearer, jira.AuthTypeMTLS:
+	case jira.AuthTypeOAuth2:
+		if cfg.Installation != strings.ToLower(jira.InstallationTypeCloud) {
+			return invalid("auth-type", fmt.Errorf("oauth2 is only supported for cloud installation"))
+		}
+	default:
+		return invalid("auth-type", fmt.Errorf("expected one of: basic, bearer, mtls, oauth2"))
+	}
+
+	if cfg.Server == "" {
+		return missing("server")
+	}
+	if err := validateServerURL(cfg.Server); err != nil {
+		return invalid("server", err)
+	}
+
+	if cfg.Login == "" && jira.AuthType(cfg.AuthType) != jira.AuthTypeBearer && jira.AuthType(cfg.AuthType) != jira.AuthTypeOAuth2 {
+		return missing("login")
+	}
+	if cfg.Login != "" {
+		validate := validateUsername
+		if cfg.Installation == strings.ToLower(jira.InstallationTypeCloud) {
+			validate = validateEmail
+		}
+		if err := validate(cfg.Login); err != nil {
+			return invalid("login", err)
+		}
+	}
+
+	if jira.AuthType(cfg.AuthType) == jira.AuthTypeMTLS {
+		for _, f := range []struct{ field, path string }{
+			{"mtls-ca-cert", cfg.MTLS.CaCert},
+			{"mtls-client-cert", cfg.MTLS.ClientCert},
+			{"mtls-client-key", cfg.MTLS.ClientKey},
+		} {
+			if f.path == "" {
+				return missing(f.field)
+			}
+			if !Exists(f.path) {
+				return invalid(f.field, fmt.Errorf("file %q does not exist", f.path))
+			}
+		}
+	}
+
+	if cfg.Project == "" {
+		return missing("project")
+	}
+
+	return nil
+}
+
+// wrapErr converts the error to a machine-readable error in non-interactive mode.
+func (c *JiraCLIConfigGenerator) wrapErr(err error, code, field string) error {
+	if !c.usrCfg.NonInteractive {
+		return err
+	}
+
+	var initErr *InitError
+	if errors.As(err, &initErr) {
+		return err
+	}
+
+	var respErr *jira.ErrUnexpectedResponse
+	if errors.As(err, &respErr) {
+		msg := strings.TrimSpace(respErr.Error())
+		if msg == "" {
+			msg = fmt.Sprintf("received unexpected response '%s'", respErr.Status)
+		}
+		if respErr.StatusCode == http.StatusUnauthorized || respErr.StatusCode == http.StatusForbidden {
+			code = InitErrAuth
+		}
+		return &InitError{Code: code, Field: field, Message: msg}
+	}
+
+	msg := err.Error()
+	switch {
+	case strings.HasPrefix(msg, "project not found"):
+		field = "project"
+	case strings.HasPrefix(msg, "board not found"):
+		field = "board"
+	}
+
+	return &InitError{Code: code, Field: field, Message: strings.Join(strings.Fields(msg), " ")}
+}
+
+func validateServerURL(val interface{}) error {
+	errInvalidURL := fmt.Errorf("not a valid URL")
+
+	str, ok := val.(string)
+	if !ok {
+		return errInvalidURL
+	}
+	u, err := url.Parse(str)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return errInvalidURL
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errInvalidURL
+	}
+
+	return nil
+}
+
+func validateEmail(val interface{}) error {
+	var (
+		emailRegex = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+\\/=?^_`{|}~-]+@[a-zA-Z0-9]" +
+			"(?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
+
+		errInvalidEmail = fmt.Errorf("not a valid email")
+	)
+
+	str, ok := val.(string)
+	if !ok {
+		return errInvalidEmail
+	}
+	if len(str) < 3 || len(str) > 254 {
+		return errInvalidEmail
+	}
+	if !emailRegex.MatchString(str) {
+		return errInvalidEmail
+	}
+
+	return nil
+}
+
+func validateUsername(val interface{}) error {
+	errInvalidUser := fmt.Errorf("not a valid user")
+
+	str, ok := val.(string)
+	if !ok {
+		return errInvalidUser
+	}
+	if len(str) < 2 || len(str) > 254 {
+		return errInvalidUser
+	}
+
+	return nil
+}
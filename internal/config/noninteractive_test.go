@@ -0,0 +1,166 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckNonInteractiveParams(t *testing.T) {
+	t.Parallel()
+
+	valid := JiraCLIConfig{
+		Installation: "cloud",
+		Server:       "https://test.atlassian.net",
+		Login:        "test@example.com",
+		Project:      "TEST",
+	}
+
+	cases := []struct {
+		name     string
+		modify   func(cfg *JiraCLIConfig)
+		expected *InitError
+	}{
+		{
+			name:   "it passes for valid params",
+			modify: func(*JiraCLIConfig) {},
+		},
+		{
+			name:     "it fails if installation is missing",
+			modify:   func(cfg *JiraCLIConfig) { cfg.Installation = "" },
+			expected: &InitError{Code: InitErrMissingValue, Field: "installation", Message: "value is required in non-interactive mode"},
+		},
+		{
+			name:     "it fails for invalid installation",
+			modify:   func(cfg *JiraCLIConfig) { cfg.Installation = "datacenter" },
+			expected: &InitError{Code: InitErrInvalidValue, Field: "installation", Message: "expected one of: cloud, local"},
+		},
+		{
+			name:     "it fails for invalid server url",
+			modify:   func(cfg *JiraCLIConfig) { cfg.Server = "test.atlassian.net" },
+			expected: &InitError{Code: InitErrInvalidValue, Field: "server", Message: "not a valid URL"},
+		},
+		{
+			name:     "it fails for invalid cloud login",
+			modify:   func(cfg *JiraCLIConfig) { cfg.Login = "test" },
+			expected: &InitError{Code: InitErrInvalidValue, Field: "login", Message: "not a valid email"},
+		},
+		{
+			name: "it doesn't require login for bearer auth",
+			modify: func(cfg *JiraCLIConfig) {
+				cfg.Installation = "local"
+				cfg.AuthType = "bearer"
+				cfg.Login = ""
+			},
+		},
+		{
+			name: "it requires mtls certificates for mtls auth",
+			modify: func(cfg *JiraCLIConfig) {
+				cfg.Installation = "local"
+				cfg.AuthType = "mtls"
+				cfg.Login = "test"
+			},
+			expected: &InitError{Code: InitErrMissingValue, Field: "mtls-ca-cert", Message: "value is required in non-interactive mode"},
+		},
+		{
+			name:     "it fails if project is missing",
+			modify:   func(cfg *JiraCLIConfig) { cfg.Project = "" },
+			expected: &InitError{Code: InitErrMissingValue, Field: "project", Message: "value is required in non-interactive mode"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := valid
+			cfg.NonInteractive = true
+			tc.modify(&cfg)
+
+			err := NewJiraCLIConfigGenerator(&cfg).checkNonInteractiveParams()
+			if tc.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestGenerateNonInteractive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/rest/api/2/myself":
+			_, _ = w.Write([]byte(`{"displayName": "Person A", "emailAddress": "test@example.com", "timeZone": "Europe/Berlin"}`))
+		case "/rest/api/2/project":
+			_, _ = w.Write([]byte(`[{"key": "TEST", "name": "Test", "style": "classic"}]`))
+		case "/rest/agile/1.0/board":
+			_, _ = w.Write([]byte(`{"total": 2, "values": [{"id": 1, "name": "Board 1", "type": "scrum"}, {"id": 42, "name": "Board 2", "type": "kanban"}]}`))
+		case "/rest/agile/1.0/board/7":
+			_, _ = w.Write([]byte(`{"id": 7, "name": "Board 7", "type": "scrum"}`))
+		case "/rest/agile/1.0/board/99":
+			w.WriteHeader(http.StatusNotFound)
+		case "/rest/api/2/issue/createmeta":
+			_, _ = w.Write([]byte(`{"projects": [{"key": "TEST", "issuetypes": [{"id": "10001", "name": "Task", "subtask": false}]}]}`))
+		case "/rest/api/2/field":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	home := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", home)
+
+	cfg := JiraCLIConfig{
+		Installation:   "cloud",
+		Server:         server.URL,
+		Login:          "test@example.com",
+		Project:        "test",
+		Board:          "42",
+		NonInteractive: true,
+	}
+
+	file, err := NewJiraCLIConfigGenerator(&cfg).Generate()
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, Dir, FileName+"."+FileType), file)
+
+	generated := viper.New()
+	generated.SetConfigFile(file)
+	assert.NoError(t, generated.ReadInConfig())
+	assert.Equal(t, "TEST", generated.GetString("project.key"))
+	assert.Equal(t, 42, generated.GetInt("board.id"))
+	assert.Equal(t, "Europe/Berlin", generated.GetString("timezone"))
+
+	// case: config exists and overwrite is not forced
+	_, err = NewJiraCLIConfigGenerator(&cfg).Generate()
+	assert.Equal(t, InitErrConfigExists, err.(*InitError).Code)
+	assert.Equal(t, 3, err.(*InitError).ExitCode())
+
+	// case: board ID is not in the first page of the boards
+	cfg.Force = true
+	cfg.Board = "7"
+	_, err = NewJiraCLIConfigGenerator(&cfg).Generate()
+	assert.NoError(t, err)
+	assert.NoError(t, generated.ReadInConfig())
+	assert.Equal(t, 7, generated.GetInt("board.id"))
+	assert.Equal(t, "Board 7", generated.GetString("board.name"))
+
+	// case: board doesn't exist
+	cfg.Board = "99"
+	_, err = NewJiraCLIConfigGenerator(&cfg).Generate()
+	assert.Equal(t, InitErrNotFound, err.(*InitError).Code)
+	assert.Equal(t, "board", err.(*InitError).Field)
+
+	assert.NoError(t, os.RemoveAll(filepath.Join(home, Dir)))
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// QueriesKey is a config key under which the named queries are saved.
+const QueriesKey = "queries"
+
+var validQueryName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// ValidateQueryName makes sure the name can be used as a config key.
+func ValidateQueryName(name string) error {
+	if !validQueryName.MatchString(name) {
+		return fmt.Errorf("invalid query name %q: use letters, numbers, dashes and underscores only", name)
+	}
+	return nil
+}
+
+// Query returns the saved query with the given name.
+// The names are case-insensitive like all config keys.
+func Query(name string) (string, bool) {
+	if ValidateQueryName(name) != nil {
+		return "", false
+	}
+	q := strings.TrimSpace(viper.GetString(QueriesKey + "." + strings.ToLower(name)))
+	return q, q != ""
+}
+
+// Queries returns the names of all saved queries in order.
+func Queries() []string {
+	names := make([]string, 0)
+	for name := range viper.GetStringMapString(QueriesKey) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SaveQuery saves the query with the given name in the user config file.
+// It returns the path of the config file.
+func SaveQuery(name, jql string) (string, error) {
+	if err := ValidateQueryName(name); err != nil {
+		return "", err
+	}
+	jql = strings.TrimSpace(jql)
+	if jql == "" {
+		return "", fmt.Errorf("unable to save an empty query")
+	}
+
+	return setUserKey(QueriesKey+"."+strings.ToLower(name), jql)
+}
+
+// DeleteQuery removes the saved query from the user config file.
+// It returns false if there is no such query.
+func DeleteQuery(name string) (bool, error) {
+	if err := ValidateQueryName(name); err != nil {
+		return false, err
+	}
+
+	return unsetUserKey(QueriesKey + "." + strings.ToLower(name))
+}
+
+// setUserKey sets the key in the user config file and returns the path of the file.
+func setUserKey(key string, val interface{}) (string, error) {
+	path, err := DefaultFile()
+	if err != nil {
+		return "", err
+	}
+	file, err := Load(path)
+	if err != nil {
+		return "", err
+	}
+
+	file.Set(key, val)
+	if err := file.Save(); err != nil {
+		return "", err
+	}
+	// Keep the loaded config in sync, so the value can be used right away.
+	viper.Set(key, val)
+
+	return file.Path(), nil
+}
+
+// unsetUserKey removes the key from the user config file.
+// It returns false if the key is not set.
+func unsetUserKey(key string) (bool, error) {
+	path, err := DefaultFile()
+	if err != nil {
+		return false, err
+	}
+	file, err := Load(path)
+	if err != nil {
+		return false, err
+	}
+	if !file.Unset(key) {
+		return false, nil
+	}
+	return true, file.Save()
+}
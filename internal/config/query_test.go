@@ -0,0 +1,37 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateQueryName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		valid bool
+	}{
+		{name: "mine", valid: true},
+		{name: "my-open_bugs2", valid: true},
+		{name: "", valid: false},
+		{name: "my bugs", valid: false},
+		{name: "my.bugs", valid: false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateQueryName(tc.name)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// RefreshOptions selects the metadata to refresh.
+// Everything is refreshed if no option is selected.
+type RefreshOptions struct {
+	IssueTypes bool
+	Fields     bool
+	Epic       bool
+}
+
+func (o RefreshOptions) all() bool {
+	return !o.IssueTypes && !o.Fields && !o.Epic
+}
+
+// Change is a change in the config value.
+// Old is empty for added and New is empty for removed values.
+type Change struct {
+	Key string
+	Old string
+	New string
+}
+
+func (c Change) String() string {
+	switch {
+	case c.Old == "":
+		return fmt.Sprintf("+ %s: %s", c.Key, c.New)
+	case c.New == "":
+		return fmt.Sprintf("- %s: %s", c.Key, c.Old)
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", c.Key, c.Old, c.New)
+	}
+}
+
+// Refresh re-fetches the metadata cached in the config at init time and
+// updates only the affected keys in the given file. It returns the list of
+// changes. The file is not saved so that the caller can decide what to do.
+func (c *JiraCLIConfigGenerator) Refresh(file *File, opts RefreshOptions) ([]Change, error) {
+	c.loadFrom(file)
+
+	if c.value.project == nil || c.value.project.Key == "" {
+		return nil, fmt.Errorf("project is not configured\n  Run 'jira init' to configure the tool")
+	}
+
+	var changes []Change
+
+	if opts.all() || opts.IssueTypes {
+		var err error
+
+		//nolint:mnd
+		isV9Compatible := c.value.version.major >= 9 || (c.value.version.major == 8 && c.value.version.minor > 4)
+		if c.value.installation == jira.InstallationTypeLocal && isV9Compatible {
+			err = c.configureIssueTypesForJiraServerV9()
+		} else {
+			err = c.configureIssueTypes()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var old []*jira.IssueType
+		if err := file.UnmarshalKey("issue.types", &old); err != nil {
+			return nil, err
+		}
+		changes = append(changes, diffIssueTypes(old, c.value.issueTypes)...)
+		file.Set("issue.types", c.value.issueTypes)
+	}
+
+	if opts.all() || opts.Fields || opts.Epic {
+		if err := c.configureFields(); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.all() || opts.Fields {
+		var old []*issueTypeFieldConf
+		if err := file.UnmarshalKey("issue.fields.custom", &old); err != nil {
+			return nil, err
+		}
+		changes = append(changes, diffCustomFields(old, c.value.customFields)...)
+		file.Set("issue.fields.custom", c.value.customFields)
+	}
+
+	if opts.all() || opts.Epic {
+		old := jira.Epic{
+			Name: fmt.Sprintf("%v", orEmpty(file.Get("epic.name"))),
+			Link: fmt.Sprintf("%v", orEmpty(file.Get("epic.link"))),
+		}
+		changes = append(changes, diffValue("epic.name", old.Name, c.value.epic.Name)...)
+		changes = append(changes, diffValue("epic.link", old.Link, c.value.epic.Link)...)
+		file.Set("epic.name", c.value.epic.Name)
+		file.Set("epic.link", c.value.epic.Link)
+	}
+
+	return changes, nil
+}
+
+// loadFrom populates generator values from the existing config.
+func (c *JiraCLIConfigGenerator) loadFrom(file *File) {
+	get := func(key string) string {
+		return fmt.Sprintf("%v", orEmpty(file.Get(key)))
+	}
+
+	c.value.installation = get("installation")
+	c.value.server = get("server")
+	c.value.login = get("login")
+	c.value.authType = jira.AuthType(get("auth_type"))
+	c.value.project = &projectConf{
+		Key:  get("project.key"),
+		Type: get("project.type"),
+	}
+	c.value.version.major, _ = file.Get("version.major").(int)
+	c.value.version.minor, _ = file.Get("version.minor").(int)
+	c.value.version.patch, _ = file.Get("version.patch").(int)
+
+	if c.jiraClient == nil {
+		c.jiraClient = api.DefaultClient(viper.GetBool("debug"))
+	}
+}
+
+func orEmpty(v interface{}) interface{} {
+	if v == nil {
+		return ""
+	}
+	return v
+}
+
+func diffIssueTypes(old, curr []*jira.IssueType) []Change {
+	describe := func(types []*jira.IssueType) map[string]string {
+		out := make(map[string]string, len(types))
+		for _, t := range types {
+			out[t.Name] = fmt.Sprintf("id=%s handle=%s subtask=%t", t.ID, t.Handle, t.Subtask)
+		}
+		return out
+	}
+	return diffNamed("issue.types", describe(old), describe(curr))
+}
+
+func diffCustomFields(old, curr []*issueTypeFieldConf) []Change {
+	describe := func(fields []*issueTypeFieldConf) map[string]string {
+		out := make(map[string]string, len(fields))
+		for _, f := range fields {
+			d := fmt.Sprintf("key=%s datatype=%s", f.Key, f.Schema.DataType)
+			if f.Schema.Items != "" {
+				d += " items=" + f.Schema.Items
+			}
+			out[f.Name] = d
+		}
+		return out
+	}
+	return diffNamed("issue.fields.custom", describe(old), describe(curr))
+}
+
+func diffNamed(key string, old, curr map[string]string) []Change {
+	var changes []Change
+
+	for name, o := range old {
+		n, ok := curr[name]
+		if !ok {
+			changes = append(changes, Change{Key: fmt.Sprintf("%s[%s]", key, name), Old: o})
+			continue
+		}
+		if n != o {
+			changes = append(changes, Change{Key: fmt.Sprintf("%s[%s]", key, name), Old: o, New: n})
+		}
+	}
+	for name, n := range curr {
+		if _, ok := old[name]; !ok {
+			changes = append(changes, Change{Key: fmt.Sprintf("%s[%s]", key, name), New: n})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+
+	return changes
+}
+
+func diffValue(key, old, curr string) []Change {
+	if old == curr {
+		return nil
+	}
+	return []Change{{Key: key, Old: old, New: curr}}
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestRefresh(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/rest/api/2/issue/createmeta":
+			assert.Equal(t, "TEST", r.URL.Query().Get("projectKeys"))
+			_, _ = w.Write([]byte(`{"projects": [{"key": "TEST", "issuetypes": [
+				{"id": "10001", "name": "Task", "untranslatedName": "Task", "subtask": false},
+				{"id": "10002", "name": "Bug", "untranslatedName": "Bug", "subtask": false},
+				{"id": "10003", "name": "Sub-task", "untranslatedName": "Sub-task", "subtask": true}
+			]}]}`))
+		case "/rest/api/2/field":
+			_, _ = w.Write([]byte(`[
+				{"id": "customfield_10011", "name": "Epic Name", "custom": true, "schema": {"type": "string"}},
+				{"id": "customfield_10014", "name": "Epic Link", "custom": true, "schema": {"type": "any"}},
+				{"id": "customfield_10020", "name": "Story Points", "custom": true, "schema": {"type": "number"}},
+				{"id": "summary", "name": "Summary", "custom": false, "schema": {"type": "string"}}
+			]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), ".config.yml")
+	content := `installation: Cloud
+server: https://test.atlassian.net
+login: test@example.com
+project:
+  key: TEST
+  type: classic
+epic:
+  name: customfield_10011
+  link: customfield_10010
+issue:
+  types:
+    - id: "10001"
+      name: Task
+      handle: Task
+      subtask: false
+    - id: "10004"
+      name: Story
+      handle: Story
+      subtask: false
+  fields:
+    custom:
+      - name: Team
+        key: customfield_10001
+        schema:
+          datatype: string
+timezone: Europe/Berlin
+`
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	file, err := Load(path)
+	assert.NoError(t, err)
+
+	gen := NewJiraCLIConfigGenerator(&JiraCLIConfig{})
+	gen.jiraClient = jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	// case: refresh only epic fields
+	changes, err := gen.Refresh(file, RefreshOptions{Epic: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Key: "epic.link", Old: "customfield_10010", New: "customfield_10014"},
+	}, changes)
+	assert.Equal(t, "Team", file.Get("issue.fields.custom").([]interface{})[0].(map[string]interface{})["name"])
+
+	// case: refresh everything
+	changes, err = gen.Refresh(file, RefreshOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Key: "issue.types[Bug]", New: "id=10002 handle=Bug subtask=false"},
+		{Key: "issue.types[Story]", Old: "id=10004 handle=Story subtask=false"},
+		{Key: "issue.types[Sub-task]", New: "id=10003 handle=Sub-task subtask=true"},
+		{Key: "issue.fields.custom[Story Points]", New: "key=customfield_10020 datatype=number"},
+		{Key: "issue.fields.custom[Team]", Old: "key=customfield_10001 datatype=string"},
+	}, changes)
+
+	assert.NoError(t, file.Save())
+
+	file, err = Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", file.Get("timezone"))
+	assert.Equal(t, "customfield_10014", file.Get("epic.link"))
+	assert.Len(t, file.Get("issue.types"), 3)
+}
+
+func TestChangeString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "+ epic.link: customfield_10014", Change{Key: "epic.link", New: "customfield_10014"}.String())
+	assert.Equal(t, "- epic.link: customfield_10014", Change{Key: "epic.link", Old: "customfield_10014"}.String())
+	assert.Equal(t, "~ epic.link: customfield_1 -> customfield_2", Change{Key: "epic.link", Old: "customfield_1", New: "customfield_2"}.String())
+}
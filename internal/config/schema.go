@@ -0,0 +1,320 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// ValueType is a type of the config value.
+type ValueType string
+
+const (
+	// ValueString is a plain string value.
+	ValueString ValueType = "string"
+	// ValueInt is an integer value.
+	ValueInt ValueType = "int"
+	// ValueBool is a boolean value.
+	ValueBool ValueType = "bool"
+	// ValueList is a list of strings.
+	ValueList ValueType = "list"
+	// ValuePath is a path to an existing file.
+	ValuePath ValueType = "path"
+	// ValueTimezone is an IANA timezone name.
+	ValueTimezone ValueType = "timezone"
+	// ValueObject is a free-form value usually generated by the tool.
+	ValueObject ValueType = "object"
+	// ValueKeys is a comma separated list of keys bound to an action in the TUI, eg: `k,up`.
+	ValueKeys ValueType = "keys"
+)
+
+// Key describes a known config key.
+//
+// A key ending with `.*` matches any direct child key,
+// eg: `tui.keys.*` matches `tui.keys.quit`.
+type Key struct {
+	Name string
+	Type ValueType
+	Enum []string
+	Help string
+}
+
+// Keys is a schema of all config keys known to the tool.
+var Keys = []Key{
+	{Name: "installation", Type: ValueString, Enum: []string{jira.InstallationTypeCloud, jira.InstallationTypeLocal}, Help: "Jira installation type"},
+	{Name: "server", Type: ValueString, Help: "Link to the jira server"},
+	{Name: "browse_server", Type: ValueString, Help: "Link to the jira web client if different from the server"},
+	{Name: "login", Type: ValueString, Help: "Jira login username or email"},
+	{Name: "api_token", Type: ValueString, Help: "Jira API token, prefer env, netrc or keyring instead"},
+	{Name: "credential_helper", Type: ValueString, Help: "Command that prints the API token to stdout"},
+	{
+		Name: "auth_type", Type: ValueString,
+		Enum: []string{jira.AuthTypeBasic.String(), jira.AuthTypeBearer.String(), jira.AuthTypeMTLS.String(), jira.AuthTypeOAuth2.String()},
+		Help: "Authentication type",
+	},
+	{Name: "insecure", Type: ValueBool, Help: "Skip TLS certificate verification"},
+	{Name: "timezone", Type: ValueTimezone, Help: "Timezone used to display dates"},
+	{Name: "num_comments", Type: ValueInt, Help: "Number of comments to show in issue view"},
+	{Name: "project.key", Type: ValueString, Help: "Default project key"},
+	{Name: "project.type", Type: ValueString, Enum: []string{jira.ProjectTypeClassic, jira.ProjectTypeNextGen}, Help: "Default project type"},
+	{Name: "board.id", Type: ValueInt, Help: "Default board ID"},
+	{Name: "board.name", Type: ValueString, Help: "Default board name"},
+	{Name: "board.type", Type: ValueString, Enum: []string{jira.BoardTypeScrum, "kanban", "simple"}, Help: "Default board type"},
+	{Name: "epic.name", Type: ValueString, Help: "Epic name custom field ID"},
+	{Name: "epic.link", Type: ValueString, Help: "Epic link custom field ID"},
+	{Name: "issue.types", Type: ValueObject, Help: "Issue types available in the project"},
+	{Name: "issue.fields.custom", Type: ValueObject, Help: "Custom fields available in the project"},
+	{Name: "issue.fields.story_points", Type: ValueString, Help: "Story points custom field ID used in the sprint reports, eg: customfield_10016"},
+	{Name: "issue.defaults.labels", Type: ValueList, Help: "Labels to add to new issues"},
+	{Name: "issue.defaults.components", Type: ValueList, Help: "Components to add to new issues"},
+	{Name: "issue.defaults.template", Type: ValuePath, Help: "Template used as a description of new issues"},
+	{Name: "tui.selection.foreground", Type: ValueString, Help: "Foreground color of the selected row"},
+	{Name: "tui.selection.background", Type: ValueString, Help: "Background color of the selected row"},
+	{Name: "tui.selection.bold", Type: ValueBool, Help: "Whether to bold the selected row"},
+	{Name: "tui.layout", Type: ValueString, Enum: []string{"table", "split"}, Help: "Layout of the interactive list, split shows the selected issue beside it"},
+	{Name: "tui.theme", Type: ValueString, Enum: tui.Themes(), Help: "Color theme of the interactive UI, auto picks dark or light based on the terminal"},
+	{Name: "tui.keymap", Type: ValueString, Enum: tui.KeymapPresets(), Help: "Key bindings preset of the interactive UI"},
+	{Name: "tui.keys.*", Type: ValueKeys, Help: "Keys bound to an action of the interactive UI, eg: tui.keys.quit: q,ctrl+q"},
+	{Name: "tui.views.*", Type: ValueObject, Help: "Column layout of the interactive table, saved per command"},
+	{Name: "mtls.ca_cert", Type: ValuePath, Help: "Path to the CA certificate"},
+	{Name: "mtls.client_cert", Type: ValuePath, Help: "Path to the client certificate"},
+	{Name: "mtls.client_key", Type: ValuePath, Help: "Path to the client key"},
+	{Name: "oauth.client_id", Type: ValueString, Help: "OAuth 2.0 app client ID"},
+	{Name: "oauth.client_secret", Type: ValueString, Help: "OAuth 2.0 app client secret, prefer JIRA_OAUTH_CLIENT_SECRET env instead"},
+	{Name: "oauth.scopes", Type: ValueList, Help: "OAuth 2.0 scopes to request"},
+	{Name: "oauth.callback_port", Type: ValueInt, Help: "Port of the OAuth 2.0 callback URL registered for the app"},
+	{Name: "oauth.auth_url", Type: ValueString, Help: "OAuth 2.0 authorization endpoint"},
+	{Name: "oauth.token_url", Type: ValueString, Help: "OAuth 2.0 token endpoint"},
+	{Name: "oauth.api_url", Type: ValueString, Help: "API gateway used to access the cloud instance"},
+	{Name: "aliases.*", Type: ValueString, Help: "Command alias, see 'jira alias'"},
+	{Name: "queries.*", Type: ValueString, Help: "Saved JQL query, see 'jira jql'"},
+	{Name: "version.major", Type: ValueInt, Help: "Jira server major version"},
+	{Name: "version.minor", Type: ValueInt, Help: "Jira server minor version"},
+	{Name: "version.patch", Type: ValueInt, Help: "Jira server patch version"},
+}
+
+// Problem is a config validation problem.
+type Problem struct {
+	Key string
+	Msg string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s", p.Key, p.Msg)
+}
+
+// LookupKey finds the schema for the given key.
+func LookupKey(name string) (*Key, bool) {
+	name = strings.ToLower(name)
+
+	for i, k := range Keys {
+		if k.Name == name {
+			return &Keys[i], true
+		}
+		if prefix, ok := strings.CutSuffix(k.Name, "*"); ok {
+			if rest, ok := strings.CutPrefix(name, prefix); ok && rest != "" && !strings.Contains(rest, ".") {
+				return &Keys[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Parse converts a string value to the type defined in the key schema.
+func (k *Key) Parse(val string) (interface{}, error) {
+	var out interface{}
+
+	switch k.Type {
+	case ValueInt:
+		n, err := strconv.Atoi(val)
+		if err != nil {
+			return nil, fmt.Errorf("expected an integer, got %q", val)
+		}
+		out = n
+	case ValueBool:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return nil, fmt.Errorf("expected a boolean, got %q", val)
+		}
+		out = b
+	case ValueList:
+		var items []string
+		for _, v := range strings.Split(val, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				items = append(items, v)
+			}
+		}
+		out = items
+	case ValueObject:
+		return nil, fmt.Errorf("value is managed by the tool and cannot be set directly")
+	default:
+		out = val
+	}
+
+	if err := k.Check(out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Check validates the value against the key schema.
+//
+//nolint:gocyclo
+func (k *Key) Check(val interface{}) error {
+	switch k.Type {
+	case ValueInt:
+		switch val.(type) {
+		case int, int64, float64:
+		default:
+			return fmt.Errorf("expected an integer, got %v", val)
+		}
+	case ValueBool:
+		if _, ok := val.(bool); !ok {
+			return fmt.Errorf("expected a boolean, got %v", val)
+		}
+	case ValueList:
+		switch val.(type) {
+		case []string, []interface{}:
+		default:
+			return fmt.Errorf("expected a list, got %v", val)
+		}
+	case ValueKeys:
+		s, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %v", val)
+		}
+		if _, err := tui.ParseKeys(s); err != nil {
+			return err
+		}
+	case ValueString, ValuePath, ValueTimezone:
+		s, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %v", val)
+		}
+		if k.Type == ValuePath && s != "" && s != "-" {
+			if _, err := os.Stat(s); err != nil {
+				return fmt.Errorf("file %q does not exist", s)
+			}
+		}
+		if k.Type == ValueTimezone && s != "" {
+			if _, err := time.LoadLocation(s); err != nil {
+				return fmt.Errorf("unknown timezone %q", s)
+			}
+		}
+	}
+
+	if s := fmt.Sprintf("%v", val); len(k.Enum) > 0 && s != "" {
+		for _, e := range k.Enum {
+			if s == e {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid value %q, expected one of: %s", s, strings.Join(k.Enum, ", "))
+	}
+
+	return nil
+}
+
+// Validate validates config settings against the schema. It reports
+// unknown keys with suggestions, invalid types, enums and file paths.
+func Validate(settings map[string]interface{}) []Problem {
+	var problems []Problem
+
+	var walk func(prefix string, m map[string]interface{})
+	walk = func(prefix string, m map[string]interface{}) {
+		for k, v := range m {
+			name := k
+			if prefix != "" {
+				name = prefix + "." + k
+			}
+			if key, ok := LookupKey(name); ok {
+				if err := key.Check(v); err != nil {
+					problems = append(problems, Problem{Key: name, Msg: err.Error()})
+				}
+				continue
+			}
+			if isKnownPrefix(name) {
+				switch sub := v.(type) {
+				case map[string]interface{}:
+					walk(name, sub)
+				case nil, string:
+					// Sections like board are written as an empty string if not configured.
+					if sub != nil && sub != "" {
+						problems = append(problems, Problem{Key: name, Msg: "expected a map of keys"})
+					}
+				default:
+					problems = append(problems, Problem{Key: name, Msg: "expected a map of keys"})
+				}
+				continue
+			}
+
+			msg := "unknown key"
+			if s := suggestKey(name); s != "" {
+				msg = fmt.Sprintf("unknown key, did you mean %q?", s)
+			}
+			problems = append(problems, Problem{Key: name, Msg: msg})
+		}
+	}
+	walk("", settings)
+
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].Key < problems[j].Key
+	})
+
+	return problems
+}
+
+func isKnownPrefix(name string) bool {
+	for _, k := range Keys {
+		if strings.HasPrefix(k.Name, name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func suggestKey(name string) string {
+	const maxDistance = 3
+
+	var (
+		suggestion string
+		best       = maxDistance + 1
+	)
+	for _, k := range Keys {
+		if strings.HasSuffix(k.Name, "*") {
+			continue
+		}
+		if d := levenshtein(name, k.Name); d < best {
+			best, suggestion = d, k.Name
+		}
+	}
+	return suggestion
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,198 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupKey(t *testing.T) {
+	t.Parallel()
+
+	k, ok := LookupKey("project.key")
+	assert.True(t, ok)
+	assert.Equal(t, "project.key", k.Name)
+
+	_, ok = LookupKey("PROJECT.KEY")
+	assert.True(t, ok)
+
+	_, ok = LookupKey("project")
+	assert.False(t, ok)
+
+	_, ok = LookupKey("unknown.key")
+	assert.False(t, ok)
+}
+
+func TestKeyParse(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		key      string
+		input    string
+		expected interface{}
+		err      string
+	}{
+		{
+			name:     "it parses string value",
+			key:      "project.key",
+			input:    "PRJ",
+			expected: "PRJ",
+		},
+		{
+			name:     "it parses integer value",
+			key:      "board.id",
+			input:    "42",
+			expected: 42,
+		},
+		{
+			name:  "it fails for invalid integer value",
+			key:   "board.id",
+			input: "forty-two",
+			err:   `expected an integer, got "forty-two"`,
+		},
+		{
+			name:     "it parses boolean value",
+			key:      "insecure",
+			input:    "true",
+			expected: true,
+		},
+		{
+			name:     "it parses list value",
+			key:      "issue.defaults.labels",
+			input:    "backend, urgent,",
+			expected: []string{"backend", "urgent"},
+		},
+		{
+			name:     "it accepts valid enum value",
+			key:      "auth_type",
+			input:    "bearer",
+			expected: "bearer",
+		},
+		{
+			name:  "it fails for invalid enum value",
+			key:   "auth_type",
+			input: "token",
+			err:   `invalid value "token", expected one of: basic, bearer, mtls, oauth2`,
+		},
+		{
+			name:  "it fails for file that doesn't exist",
+			key:   "mtls.ca_cert",
+			input: "/path/to/invalid/ca.crt",
+			err:   `file "/path/to/invalid/ca.crt" does not exist`,
+		},
+		{
+			name:  "it fails for invalid timezone",
+			key:   "timezone",
+			input: "Mars/Olympus",
+			err:   `unknown timezone "Mars/Olympus"`,
+		},
+		{
+			name:     "it parses key bindings",
+			key:      "tui.keys.quit",
+			input:    "q,ctrl+q",
+			expected: "q,ctrl+q",
+		},
+		{
+			name:  "it fails for invalid key bindings",
+			key:   "tui.keys.quit",
+			input: "q,hyper+q",
+			err:   `invalid key "hyper+q"`,
+		},
+		{
+			name:  "it doesn't allow setting generated values",
+			key:   "issue.types",
+			input: "Bug",
+			err:   "value is managed by the tool and cannot be set directly",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			k, ok := LookupKey(tc.key)
+			assert.True(t, ok)
+
+			got, err := k.Parse(tc.input)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	settings := map[string]interface{}{
+		"installation": "Cloud",
+		"server":       "https://test.atlassian.net",
+		"auth_type":    "token",
+		"timezone":     "Europe/Berlin",
+		"board":        "",
+		"project": map[string]interface{}{
+			"key":  "TEST",
+			"type": "classic",
+			"name": "Test",
+		},
+		"num_comment": 10,
+		"tui": map[string]interface{}{
+			"selection": map[string]interface{}{
+				"bold": "yes",
+			},
+		},
+		"unrelated": true,
+	}
+
+	assert.Equal(t, []Problem{
+		{Key: "auth_type", Msg: `invalid value "token", expected one of: basic, bearer, mtls, oauth2`},
+		{Key: "num_comment", Msg: `unknown key, did you mean "num_comments"?`},
+		{Key: "project.name", Msg: `unknown key, did you mean "project.type"?`},
+		{Key: "tui.selection.bold", Msg: "expected a boolean, got yes"},
+		{Key: "unrelated", Msg: "unknown key"},
+	}, Validate(settings))
+}
+
+func TestValidateLocal(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(root, ".github"), 0o700))
+	assert.NoError(t, os.WriteFile(filepath.Join(root, ".github", "issue.tmpl"), []byte("## Steps"), 0o600))
+
+	settings := map[string]interface{}{
+		"server": "https://evil.example.com",
+		"project": map[string]interface{}{
+			"key": "TEST",
+		},
+		"issue": map[string]interface{}{
+			"defaults": map[string]interface{}{
+				// Relative to the config file, not to the working directory.
+				"template": ".github/issue.tmpl",
+			},
+		},
+	}
+
+	assert.Equal(t, []Problem{
+		{Key: "server", Msg: "key is restricted to the user config and will be ignored"},
+	}, ValidateLocal(filepath.Join(root, LocalFileName), settings))
+
+	settings = map[string]interface{}{
+		"issue": map[string]interface{}{
+			"defaults": map[string]interface{}{
+				"template": "missing.tmpl",
+			},
+		},
+	}
+	problems := ValidateLocal(filepath.Join(root, LocalFileName), settings)
+	assert.Len(t, problems, 1)
+	assert.Equal(t, "issue.defaults.template", problems[0].Key)
+}
@@ -0,0 +1,493 @@
+package doctor
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	hintInit        = "Run 'jira init' to configure the tool"
+	hintLogin       = "Run 'jira auth login' to store the token in the keyring, or set JIRA_API_TOKEN env"
+	hintRefresh     = "Run 'jira config refresh' to discover the fields again"
+	hintInstallCA   = "If the server uses a private CA, set mtls.ca_cert or add the CA to the system trust store"
+	hintNetwork     = "Check the server URL, your VPN and firewall, and the proxy env vars"
+	hintClientCert  = "Renew the client certificate and update mtls.client_cert and mtls.client_key"
+	hintServerCert  = "Ask the server administrator to renew the certificate"
+	hintProjectList = "Run 'jira project list' to see the projects and 'jira config set project.key KEY' to update it"
+)
+
+// proxyEnv are the env vars that configure the proxy, in the order Go reads them.
+var proxyEnv = []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy", "NO_PROXY", "no_proxy"}
+
+func (d *doctor) getenv(key string) string {
+	if d.cfg.Getenv != nil {
+		return d.cfg.Getenv(key)
+	}
+	return os.Getenv(key)
+}
+
+func (d *doctor) checkConfig() *Result {
+	if !d.cfg.Exists {
+		return fail(hintInit, "Config file %s doesn't exist", d.cfg.File)
+	}
+	if n := len(d.cfg.Problems); n > 0 {
+		return warn(
+			"Run 'jira config validate' for details and fix them with 'jira config set' or 'jira config edit'",
+			"Config file %s has %d problem(s): %s", d.cfg.File, n, strings.Join(d.cfg.Problems, "; "),
+		)
+	}
+	return ok("Using %s", d.cfg.File)
+}
+
+func (d *doctor) checkProxy() *Result {
+	var set []string
+	for _, k := range proxyEnv {
+		if d.getenv(k) != "" {
+			set = append(set, k)
+		}
+	}
+
+	u, err := url.Parse(d.cfg.Server)
+	if d.cfg.Server == "" || err != nil || u.Host == "" {
+		if len(set) == 0 {
+			return ok("No proxy env vars set")
+		}
+		return ok("Proxy env vars set: %s", strings.Join(set, ", "))
+	}
+
+	proxyFunc := d.cfg.Proxy
+	if proxyFunc == nil {
+		proxyFunc = http.ProxyFromEnvironment
+	}
+	proxy, err := proxyFunc(&http.Request{URL: u})
+	switch {
+	case err != nil:
+		return fail("Fix the proxy URL in "+strings.Join(set, ", "), "Invalid proxy: %s", err)
+	case proxy != nil:
+		return ok("Requests to %s go through the proxy %s", u.Host, proxy.Redacted())
+	case len(set) > 0:
+		return ok("Requests to %s don't use a proxy, proxy env vars set: %s", u.Host, strings.Join(set, ", "))
+	case d.getenv("ALL_PROXY") != "" || d.getenv("all_proxy") != "":
+		return warn("Set HTTPS_PROXY to the same value", "ALL_PROXY is set, but only HTTPS_PROXY and HTTP_PROXY are supported")
+	}
+	return ok("No proxy env vars set")
+}
+
+func (d *doctor) checkToken() *Result {
+	if d.cfg.AuthType == jira.AuthTypeMTLS {
+		return ok("Not needed for %s auth", d.cfg.AuthType)
+	}
+	if d.cfg.TokenErr != nil {
+		return fail("Check the credential_helper command in the config", "Unable to get the token: %s", d.cfg.TokenErr)
+	}
+	if d.cfg.TokenSource == "" || d.cfg.TokenSource == "none" {
+		if d.cfg.AuthType == jira.AuthTypeOAuth2 {
+			return fail("Run 'jira auth login' to authorize the tool", "No OAuth 2.0 token found")
+		}
+		return fail(hintLogin, "No API token found")
+	}
+	if exp := d.cfg.TokenExpiry; exp != nil {
+		if d.now().After(*exp) {
+			return fail(hintLogin, "Token from %s expired on %s", d.cfg.TokenSource, exp.Format(time.RFC1123))
+		}
+		return ok("Token resolved from %s, expires on %s", d.cfg.TokenSource, exp.Format(time.RFC1123))
+	}
+	return ok("Token resolved from %s", d.cfg.TokenSource)
+}
+
+// probe is the response to a plain request to the server.
+type probe struct {
+	status  string
+	latency time.Duration
+	proxy   *url.URL
+	date    time.Time
+	certs   []*x509.Certificate
+}
+
+func (d *doctor) checkConnection() *Result {
+	if d.cfg.Server == "" {
+		return fail(hintInit, "No server configured")
+	}
+	u, err := url.Parse(d.cfg.Server)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fail("Set the server URL with 'jira config set server https://...'", "Invalid server URL %q", d.cfg.Server)
+	}
+
+	p, err := d.sendProbe(u)
+	if err != nil {
+		return fail(connectionHint(err), "Unable to reach %s: %s", u.Host, err)
+	}
+	d.probe = p
+
+	via := ""
+	if p.proxy != nil {
+		via = " via " + p.proxy.Redacted()
+	}
+	return ok("Reached %s%s in %s (%s)", u.Host, via, p.latency.Round(time.Millisecond), p.status)
+}
+
+func (d *doctor) sendProbe(u *url.URL) (*probe, error) {
+	tlsConf, err := tlsConfig(d.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client certificate: %w", err)
+	}
+
+	var p probe
+
+	proxyFunc := d.cfg.Proxy
+	if proxyFunc == nil {
+		proxyFunc = http.ProxyFromEnvironment
+	}
+	client := http.Client{
+		Timeout: probeTimeout,
+		Transport: &http.Transport{
+			Proxy: func(r *http.Request) (*url.URL, error) {
+				pu, err := proxyFunc(r)
+				p.proxy = pu
+				return pu, err
+			},
+			TLSClientConfig: tlsConf,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	res, err := client.Do(req)
+	if err != nil {
+		var ue *url.Error
+		if errors.As(err, &ue) {
+			err = ue.Err
+		}
+		return nil, err
+	}
+	_ = res.Body.Close()
+
+	p.status, p.latency = res.Status, time.Since(start)
+	if date, err := http.ParseTime(res.Header.Get("Date")); err == nil {
+		p.date = date
+	}
+	if res.TLS != nil {
+		p.certs = res.TLS.PeerCertificates
+	}
+	return &p, nil
+}
+
+func connectionHint(err error) string {
+	var (
+		dnsErr *net.DNSError
+		opErr  *net.OpError
+	)
+	switch {
+	case errors.As(err, &dnsErr):
+		return "Check the server URL, the host name can't be resolved. Connect to the VPN if the server is internal"
+	case errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err):
+		return "The server didn't respond in time. " + hintNetwork
+	case errors.As(err, &opErr) && opErr.Op == "remote error":
+		return "The server rejected the TLS handshake, check the mtls config if the server requires a client certificate"
+	}
+	return hintNetwork
+}
+
+func (d *doctor) checkTLS() *Result {
+	u, _ := url.Parse(d.cfg.Server)
+	if u.Scheme != "https" {
+		return warn("Use an https:// server URL if the server supports it", "Connection to %s is not encrypted", u.Host)
+	}
+	if d.probe == nil || len(d.probe.certs) == 0 {
+		return skip("No certificate received from the server")
+	}
+
+	leaf := d.probe.certs[0]
+
+	roots, err := x509.SystemCertPool()
+	if err != nil {
+		roots = x509.NewCertPool()
+	}
+	if d.cfg.MTLS.CaCert != "" {
+		pem, err := os.ReadFile(d.cfg.MTLS.CaCert)
+		if err != nil {
+			return fail("Fix the mtls.ca_cert path in the config", "Unable to read the CA certificate: %s", err)
+		}
+		if !roots.AppendCertsFromPEM(pem) {
+			return fail("Make sure mtls.ca_cert is a PEM encoded certificate", "No certificate found in %s", d.cfg.MTLS.CaCert)
+		}
+	}
+
+	inter := x509.NewCertPool()
+	for _, c := range d.probe.certs[1:] {
+		inter.AddCert(c)
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{
+		DNSName:       u.Hostname(),
+		Roots:         roots,
+		Intermediates: inter,
+		CurrentTime:   d.now(),
+	})
+	if err != nil {
+		if d.cfg.Insecure {
+			return warn(hintInstallCA+", then unset insecure", "Certificate is not trusted and the verification is disabled with insecure: %s", err)
+		}
+		return fail(hintInstallCA, "Certificate is not trusted: %s", err)
+	}
+
+	if r := d.certExpiry("Server certificate", leaf, hintServerCert); r != nil {
+		return r
+	}
+	return ok(
+		"Certificate for %s issued by %s is valid until %s",
+		u.Hostname(), issuer(leaf), leaf.NotAfter.Format(time.DateOnly),
+	)
+}
+
+func issuer(cert *x509.Certificate) string {
+	if cert.Issuer.CommonName != "" {
+		return cert.Issuer.CommonName
+	}
+	if len(cert.Issuer.Organization) > 0 {
+		return cert.Issuer.Organization[0]
+	}
+	return cert.Issuer.String()
+}
+
+func (d *doctor) checkClientCert() *Result {
+	m := d.cfg.MTLS
+	if d.cfg.AuthType != jira.AuthTypeMTLS && m.ClientCert == "" {
+		return skip("Not using mTLS")
+	}
+	if m.ClientCert == "" || m.ClientKey == "" {
+		return fail("Set mtls.client_cert and mtls.client_key in the config", "Client certificate or key is not configured")
+	}
+
+	conf, err := tlsConfig(d.cfg)
+	if err != nil {
+		return fail("Make sure mtls.client_cert and mtls.client_key are a matching PEM encoded pair", "Invalid client certificate: %s", err)
+	}
+	leaf, err := x509.ParseCertificate(conf.Certificates[0].Certificate[0])
+	if err != nil {
+		return fail(hintClientCert, "Invalid client certificate: %s", err)
+	}
+	if r := d.certExpiry("Client certificate", leaf, hintClientCert); r != nil {
+		return r
+	}
+	return ok("Client certificate for %s is valid until %s", leaf.Subject.CommonName, leaf.NotAfter.Format(time.DateOnly))
+}
+
+func (d *doctor) checkClock() *Result {
+	if d.probe == nil || d.probe.date.IsZero() {
+		return skip("Server didn't send its time")
+	}
+
+	// The server time is taken roughly in the middle of the request.
+	local := d.now().Add(-d.probe.latency / 2)
+	skew := local.Sub(d.probe.date).Round(time.Second)
+
+	abs := skew
+	if abs < 0 {
+		abs = -abs
+	}
+	hint := "Sync the system clock, eg: enable NTP. Tokens and signed requests are rejected if the clock is off"
+
+	switch {
+	case abs >= clockSkewFailure:
+		return fail(hint, "Local clock is off by %s from the server", skew)
+	case abs >= clockSkewWarning:
+		return warn(hint, "Local clock is off by %s from the server", skew)
+	}
+	return ok("Local clock is in sync with the server")
+}
+
+func (d *doctor) checkAuth() *Result {
+	me, err := d.cfg.Client.Me()
+	if err == nil {
+		who := or(me.Email, me.Login)
+		if me.Name == "" || who == "" {
+			return ok("Authenticated as %s", or(me.Name, who, d.cfg.Login))
+		}
+		return ok("Authenticated as %s (%s)", me.Name, who)
+	}
+
+	switch statusCode(err) {
+	case http.StatusUnauthorized:
+		hint := hintLogin + ". The token may be revoked or expired"
+		switch d.cfg.AuthType {
+		case jira.AuthTypeBasic, "":
+			if d.cfg.Installation == jira.InstallationTypeLocal {
+				hint = "Check the login and password or set auth_type to bearer if you use a personal access token"
+			}
+		case jira.AuthTypeBearer:
+			hint = "Check the personal access token or set auth_type to basic if you use a password"
+		}
+		return fail(hint, "Server rejected the credentials for %s: %s", d.cfg.Login, responseError(err))
+	case http.StatusForbidden:
+		return fail(
+			"Log in to the server in the browser, the account may be locked or require a CAPTCHA",
+			"Access denied for %s: %s", d.cfg.Login, responseError(err),
+		)
+	case http.StatusNotFound:
+		return fail("Check the server URL, it should point to the Jira root", "Jira API not found at %s", d.cfg.Server)
+	}
+	return fail(hintNetwork, "Unable to authenticate: %s", responseError(err))
+}
+
+func (d *doctor) checkServer() *Result {
+	info, err := d.cfg.Client.ServerInfo()
+	if err != nil {
+		return fail("Check the server URL, it should point to the Jira root", "Unable to get the server info: %s", responseError(err))
+	}
+
+	cloud := info.DeploymentType == jira.InstallationTypeCloud
+	switch {
+	case cloud && d.cfg.Installation == jira.InstallationTypeLocal:
+		return fail("Run 'jira config set installation Cloud'", "Server is Jira Cloud, but the installation is %s", d.cfg.Installation)
+	case !cloud && d.cfg.Installation != jira.InstallationTypeLocal:
+		return fail(
+			"Run 'jira config set installation Local'",
+			"Server is Jira %s, but the installation is %s", info.DeploymentType, or(d.cfg.Installation, jira.InstallationTypeCloud),
+		)
+	}
+
+	if d.cfg.Version != [3]int{} && len(info.VersionNumbers) == 3 {
+		if got := [3]int(info.VersionNumbers); got != d.cfg.Version {
+			return warn(
+				fmt.Sprintf("Run 'jira config set version.major %d', then minor and patch", got[0]),
+				"Server was upgraded to %s, but the config has %d.%d.%d", info.Version,
+				d.cfg.Version[0], d.cfg.Version[1], d.cfg.Version[2],
+			)
+		}
+	}
+	return ok("Jira %s %s", info.DeploymentType, info.Version)
+}
+
+func (d *doctor) checkProject() *Result {
+	if d.cfg.Project == "" {
+		return warn("Run 'jira config set project.key KEY' or use the --project flag", "No default project configured")
+	}
+	p, err := d.cfg.Client.GetProject(d.cfg.Project)
+	if err != nil {
+		if statusCode(err) == http.StatusNotFound {
+			return fail(hintProjectList, "Project %s doesn't exist or you don't have access to it", d.cfg.Project)
+		}
+		return fail(hintNetwork, "Unable to get the project %s: %s", d.cfg.Project, responseError(err))
+	}
+	return ok("Project %s (%s)", p.Key, p.Name)
+}
+
+func (d *doctor) checkBoard() *Result {
+	if d.cfg.BoardID == 0 {
+		return skip("No default board configured")
+	}
+	b, err := d.cfg.Client.GetBoard(d.cfg.BoardID)
+	if err != nil {
+		if code := statusCode(err); code == http.StatusNotFound || code == http.StatusBadRequest {
+			return fail(
+				"Run 'jira init' to choose the board again or 'jira config set board.id ID'",
+				"Board %d doesn't exist or you don't have access to it", d.cfg.BoardID,
+			)
+		}
+		return fail(hintNetwork, "Unable to get the board %d: %s", d.cfg.BoardID, responseError(err))
+	}
+	return ok("Board %s (%d, %s)", b.Name, b.ID, b.Type)
+}
+
+func (d *doctor) loadFields() error {
+	if d.fields != nil {
+		return nil
+	}
+	fields, err := d.cfg.Client.GetField()
+	if err != nil {
+		return err
+	}
+	d.fields = fields
+	return nil
+}
+
+func (d *doctor) field(id string) *jira.Field {
+	for _, f := range d.fields {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+func (d *doctor) checkEpicFields() *Result {
+	configured := []struct{ key, id string }{
+		{"epic.name", d.cfg.EpicName},
+		{"epic.link", d.cfg.EpicLink},
+	}
+	if d.cfg.EpicName == "" && d.cfg.EpicLink == "" {
+		return skip("No epic fields configured")
+	}
+	if err := d.loadFields(); err != nil {
+		return fail(hintNetwork, "Unable to get the fields: %s", responseError(err))
+	}
+
+	var found, missing []string
+	for _, c := range configured {
+		if c.id == "" {
+			continue
+		}
+		if f := d.field(c.id); f != nil {
+			found = append(found, fmt.Sprintf("%s is %s", c.key, f.Name))
+		} else {
+			missing = append(missing, fmt.Sprintf("%s %s", c.key, c.id))
+		}
+	}
+	if len(missing) > 0 {
+		return fail(hintRefresh, "Fields not found: %s", strings.Join(missing, ", "))
+	}
+	return ok("%s", strings.Join(found, ", "))
+}
+
+func (d *doctor) checkCustomFields() *Result {
+	if len(d.cfg.CustomFields) == 0 {
+		return skip("No custom fields configured")
+	}
+	if err := d.loadFields(); err != nil {
+		return fail(hintNetwork, "Unable to get the fields: %s", responseError(err))
+	}
+
+	var missing []string
+	for _, cf := range d.cfg.CustomFields {
+		if d.field(cf.Key) == nil {
+			missing = append(missing, fmt.Sprintf("%s (%s)", cf.Name, cf.Key))
+		}
+	}
+	if len(missing) > 0 {
+		return fail(
+			hintRefresh, "%d of %d custom fields not found: %s",
+			len(missing), len(d.cfg.CustomFields), strings.Join(missing, ", "),
+		)
+	}
+	return ok("All %d custom fields exist", len(d.cfg.CustomFields))
+}
+
+// or returns the first non-empty string.
+func or(s ...string) string {
+	for _, v := range s {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
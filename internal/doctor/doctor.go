@@ -0,0 +1,252 @@
+// Package doctor diagnoses the configuration and the connection to the Jira server.
+//
+// Each check reports a status, a message and a hint on how to fix the problem.
+// The checks that depend on a failed check, eg: the project lookup when the
+// server is not reachable, are skipped instead of failing with the same error.
+package doctor
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Status is a result of a check.
+type Status string
+
+// Statuses of a check.
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip"
+)
+
+// Names of the checks in the order they are run.
+const (
+	CheckConfig       = "config"
+	CheckProxy        = "proxy"
+	CheckToken        = "token"
+	CheckConnection   = "connection"
+	CheckTLS          = "tls"
+	CheckClientCert   = "client_cert"
+	CheckClock        = "clock"
+	CheckAuth         = "auth"
+	CheckServer       = "server"
+	CheckProject      = "project"
+	CheckBoard        = "board"
+	CheckEpicFields   = "epic_fields"
+	CheckCustomFields = "custom_fields"
+)
+
+const (
+	probeTimeout = 10 * time.Second
+	// certExpiryWarning is how long before the expiry a certificate is reported.
+	certExpiryWarning = 14 * 24 * time.Hour
+	// Clock skew breaks the token expiry checks and the signed requests.
+	clockSkewWarning = time.Minute
+	clockSkewFailure = 5 * time.Minute
+)
+
+// Result is a result of a single check.
+type Result struct {
+	Name    string `json:"name" yaml:"name"`
+	Status  Status `json:"status" yaml:"status"`
+	Message string `json:"message" yaml:"message"`
+	Hint    string `json:"hint,omitempty" yaml:"hint,omitempty"`
+}
+
+// Report is the result of all checks.
+type Report struct {
+	OK     bool      `json:"ok" yaml:"ok"`
+	Checks []*Result `json:"checks" yaml:"checks"`
+}
+
+// Failed returns the number of failed checks.
+func (r *Report) Failed() int {
+	n := 0
+	for _, c := range r.Checks {
+		if c.Status == StatusFail {
+			n++
+		}
+	}
+	return n
+}
+
+// Config is the resolved configuration to check.
+type Config struct {
+	// File is the user config file, Problems are the schema problems found in it.
+	File     string
+	Exists   bool
+	Problems []string
+
+	Server       string
+	Login        string
+	AuthType     jira.AuthType
+	Installation string
+	Insecure     bool
+	MTLS         jira.MTLSConfig
+
+	TokenSource string
+	TokenExpiry *time.Time
+	TokenErr    error
+
+	Project      string
+	BoardID      int
+	EpicName     string
+	EpicLink     string
+	CustomFields []jira.IssueTypeField
+	// Version is the server version saved in the config, if any.
+	Version [3]int
+
+	Client *jira.Client
+
+	// Proxy returns the proxy for the request, see http.ProxyFromEnvironment.
+	Proxy func(*http.Request) (*url.URL, error)
+	// Getenv reads the env vars, see os.Getenv.
+	Getenv func(string) string
+	// Now returns the current time, see time.Now.
+	Now func() time.Time
+}
+
+// Run runs all checks.
+func Run(cfg *Config) *Report {
+	d := doctor{cfg: cfg, results: make(map[string]*Result)}
+
+	d.run(CheckConfig, d.checkConfig)
+	d.run(CheckProxy, d.checkProxy)
+	d.run(CheckToken, d.checkToken)
+	d.run(CheckConnection, d.checkConnection)
+	d.run(CheckTLS, d.checkTLS, CheckConnection)
+	d.run(CheckClientCert, d.checkClientCert)
+	d.run(CheckClock, d.checkClock, CheckConnection)
+	d.run(CheckAuth, d.checkAuth, CheckConnection, CheckToken)
+	d.run(CheckServer, d.checkServer, CheckConnection)
+	d.run(CheckProject, d.checkProject, CheckAuth)
+	d.run(CheckBoard, d.checkBoard, CheckAuth)
+	d.run(CheckEpicFields, d.checkEpicFields, CheckAuth)
+	d.run(CheckCustomFields, d.checkCustomFields, CheckAuth)
+
+	report := Report{Checks: d.order}
+	report.OK = report.Failed() == 0
+	return &report
+}
+
+type doctor struct {
+	cfg     *Config
+	results map[string]*Result
+	order   []*Result
+
+	// probe is the response of the request sent to the server in the connection check.
+	probe *probe
+	// fields are the fields fetched from the server, shared by the field checks.
+	fields []*jira.Field
+}
+
+// run runs the check unless any of the checks it depends on failed or was skipped.
+func (d *doctor) run(name string, check func() *Result, deps ...string) {
+	var res *Result
+	for _, dep := range deps {
+		if r := d.results[dep]; r != nil && (r.Status == StatusFail || r.Status == StatusSkip) {
+			res = skip("Skipped, the %s check didn't pass", r.Name)
+			break
+		}
+	}
+	if res == nil {
+		res = check()
+	}
+	res.Name = name
+
+	d.results[name] = res
+	d.order = append(d.order, res)
+}
+
+func (d *doctor) now() time.Time {
+	if d.cfg.Now != nil {
+		return d.cfg.Now()
+	}
+	return time.Now()
+}
+
+func ok(format string, args ...interface{}) *Result {
+	return &Result{Status: StatusOK, Message: fmt.Sprintf(format, args...)}
+}
+
+func skip(format string, args ...interface{}) *Result {
+	return &Result{Status: StatusSkip, Message: fmt.Sprintf(format, args...)}
+}
+
+func warn(hint, format string, args ...interface{}) *Result {
+	return &Result{Status: StatusWarn, Message: fmt.Sprintf(format, args...), Hint: hint}
+}
+
+func fail(hint, format string, args ...interface{}) *Result {
+	return &Result{Status: StatusFail, Message: fmt.Sprintf(format, args...), Hint: hint}
+}
+
+// responseError describes the error returned by the jira client.
+func responseError(err error) string {
+	var e *jira.ErrUnexpectedResponse
+	if !errors.As(err, &e) {
+		return err.Error()
+	}
+	msg := e.Status
+	if msg == "" {
+		msg = fmt.Sprintf("HTTP %d", e.StatusCode)
+	}
+	for _, m := range e.Body.ErrorMessages {
+		msg += ": " + m
+	}
+	return msg
+}
+
+func statusCode(err error) int {
+	var e *jira.ErrUnexpectedResponse
+	if errors.As(err, &e) {
+		return e.StatusCode
+	}
+	return 0
+}
+
+// certExpiry reports the validity of the certificate.
+func (d *doctor) certExpiry(what string, cert *x509.Certificate, hint string) *Result {
+	now := d.now()
+	switch {
+	case now.Before(cert.NotBefore):
+		return fail(hint, "%s is not valid before %s", what, cert.NotBefore.Format(time.DateOnly))
+	case now.After(cert.NotAfter):
+		return fail(hint, "%s expired on %s", what, cert.NotAfter.Format(time.DateOnly))
+	case cert.NotAfter.Sub(now) < certExpiryWarning:
+		return warn(hint, "%s expires on %s, in %s", what, cert.NotAfter.Format(time.DateOnly), days(cert.NotAfter.Sub(now)))
+	}
+	return nil
+}
+
+func days(d time.Duration) string {
+	n := int(d.Hours() / 24)
+	if n == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", n)
+}
+
+func tlsConfig(cfg *Config) (*tls.Config, error) {
+	// The chain is verified separately, so that an invalid certificate
+	// is reported by the TLS check instead of failing the connection.
+	conf := tls.Config{InsecureSkipVerify: true} //nolint:gosec
+
+	if cfg.MTLS.ClientCert != "" && cfg.MTLS.ClientKey != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.MTLS.ClientCert, cfg.MTLS.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+	return &conf, nil
+}
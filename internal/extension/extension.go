@@ -0,0 +1,329 @@
+// Package extension manages the jira-<name> executables that extend the CLI.
+//
+// An extension is either installed from a git repository into the extensions
+// directory, or is any jira-<name> executable found on PATH. An installed
+// extension is a clone of the repository with the jira-<name> executable in
+// its root, so that it can be upgraded with a pull.
+package extension
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/cli/safeexec"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// Prefix is the prefix of the extension executables.
+const Prefix = "jira-"
+
+// Sources of the extensions.
+const (
+	SourceInstalled = "installed"
+	SourcePath      = "path"
+)
+
+var (
+	// ErrNotFound is returned if the extension doesn't exist.
+	ErrNotFound = errors.New("extension not found")
+	// ErrNotInstalled is returned if the extension is not managed by the manager, eg: on upgrade.
+	ErrNotInstalled = errors.New("extension is not installed with 'jira extension install'")
+)
+
+// Extension is a jira-<name> executable.
+type Extension struct {
+	Name   string `json:"name" yaml:"name"`
+	Path   string `json:"path" yaml:"path"`
+	Source string `json:"source" yaml:"source"`
+	// URL and Version are only set for the installed extensions.
+	URL     string `json:"url,omitempty" yaml:"url,omitempty"`
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+}
+
+// Manager finds, installs, upgrades and removes the extensions.
+type Manager struct {
+	dir string
+	git string
+	// pathDirs are the directories searched for the extensions after the extensions directory.
+	pathDirs []string
+}
+
+// NewManager creates a manager for the extensions installed in the given directory.
+// The other extensions are looked up in the directories of PATH env.
+func NewManager(dir string) *Manager {
+	return &Manager{
+		dir:      dir,
+		git:      "git",
+		pathDirs: filepath.SplitList(os.Getenv("PATH")),
+	}
+}
+
+// DefaultDir returns the default directory the extensions are installed to.
+func DefaultDir() (string, error) {
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, config.Dir, "extensions"), nil
+}
+
+// Dir returns the directory the extensions are installed to.
+func (m *Manager) Dir() string {
+	return m.dir
+}
+
+// Find returns the extension with the given name. The installed
+// extensions take precedence over the ones found on PATH.
+func (m *Manager) Find(name string) (*Extension, error) {
+	if !validName(name) {
+		return nil, ErrNotFound
+	}
+	if ext, err := m.installed(name); err == nil {
+		return ext, nil
+	}
+	for _, dir := range m.pathDirs {
+		if path, ok := executable(dir, Prefix+name); ok {
+			return &Extension{Name: name, Path: path, Source: SourcePath}, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// List returns all extensions sorted by name. An extension found on PATH
+// is skipped if an extension with the same name is already found.
+func (m *Manager) List() ([]*Extension, error) {
+	var (
+		out  []*Extension
+		seen = make(map[string]bool)
+	)
+
+	entries, err := os.ReadDir(m.dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, e := range entries {
+		name, ok := nameOf(e.Name())
+		if !ok || !e.IsDir() {
+			continue
+		}
+		if ext, err := m.installed(name); err == nil {
+			out = append(out, m.describe(ext))
+			seen[name] = true
+		}
+	}
+
+	for _, dir := range m.pathDirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			name, ok := nameOf(e.Name())
+			if !ok || seen[name] {
+				continue
+			}
+			if path, ok := executable(dir, e.Name()); ok {
+				out = append(out, &Extension{Name: name, Path: path, Source: SourcePath})
+				seen[name] = true
+			}
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// Install clones the git repository into the extensions directory. The repository
+// can be a URL, a local path or an `owner/repo` shorthand for a GitHub repository.
+// Its name must start with jira- and it must have an executable of the same name.
+func (m *Manager) Install(repo string) (*Extension, error) {
+	url, err := repoURL(repo)
+	if err != nil {
+		return nil, err
+	}
+	name, err := RepoName(url)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(m.dir, Prefix+name)
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("extension %q is already installed", name)
+	}
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.run("", "clone", "--quiet", url, dir); err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	ext, err := m.installed(name)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, fmt.Errorf("repository doesn't have an executable %s%s in its root", Prefix, name)
+	}
+	return m.describe(ext), nil
+}
+
+// Upgrade pulls the latest changes of the installed extension.
+// It returns false if the extension is already up to date.
+func (m *Manager) Upgrade(name string) (*Extension, bool, error) {
+	ext, err := m.Find(name)
+	if err != nil {
+		return nil, false, err
+	}
+	if ext.Source != SourceInstalled {
+		return nil, false, ErrNotInstalled
+	}
+	m.describe(ext)
+
+	dir := filepath.Dir(ext.Path)
+	if _, err := m.run(dir, "pull", "--quiet", "--ff-only"); err != nil {
+		return nil, false, err
+	}
+
+	upgraded, err := m.installed(name)
+	if err != nil {
+		return nil, false, err
+	}
+	m.describe(upgraded)
+	return upgraded, upgraded.Version != ext.Version, nil
+}
+
+// Remove deletes the installed extension.
+func (m *Manager) Remove(name string) error {
+	ext, err := m.Find(name)
+	if err != nil {
+		return err
+	}
+	if ext.Source != SourceInstalled {
+		return ErrNotInstalled
+	}
+	return os.RemoveAll(filepath.Dir(ext.Path))
+}
+
+// installed returns the extension installed in the extensions directory.
+func (m *Manager) installed(name string) (*Extension, error) {
+	dir := filepath.Join(m.dir, Prefix+name)
+
+	path, ok := executable(dir, Prefix+name)
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return &Extension{Name: name, Path: path, Source: SourceInstalled}, nil
+}
+
+// describe sets the repository URL and the version of the installed extension.
+// It is kept out of Find, so that running an extension doesn't need git.
+func (m *Manager) describe(ext *Extension) *Extension {
+	dir := filepath.Dir(ext.Path)
+	if out, err := m.run(dir, "config", "--get", "remote.origin.url"); err == nil {
+		ext.URL = out
+	}
+	if out, err := m.run(dir, "rev-parse", "--short", "HEAD"); err == nil {
+		ext.Version = out
+	}
+	return ext
+}
+
+// run runs the git command in the given directory and returns its trimmed output.
+func (m *Manager) run(dir string, args ...string) (string, error) {
+	git, err := safeexec.LookPath(m.git)
+	if err != nil {
+		return "", fmt.Errorf("git is required to manage the extensions: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(git, args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s failed: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s failed: %w", args[0], err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// RepoName returns the name of the extension installed from the repository.
+func RepoName(repo string) (string, error) {
+	base := strings.TrimSuffix(filepath.Base(strings.TrimRight(repo, "/")), ".git")
+	if i := strings.LastIndexAny(base, ":"); i >= 0 {
+		base = base[i+1:]
+	}
+	name, ok := nameOf(base)
+	if !ok {
+		return "", fmt.Errorf("repository name must start with %q", Prefix)
+	}
+	return name, nil
+}
+
+// repoURL returns the URL to clone the repository from.
+func repoURL(repo string) (string, error) {
+	repo = strings.TrimSpace(repo)
+
+	switch {
+	case repo == "":
+		return "", fmt.Errorf("repository is required")
+	case strings.Contains(repo, "://") || strings.HasPrefix(repo, "git@"):
+		return repo, nil
+	}
+
+	if _, err := os.Stat(repo); err == nil {
+		// Keep the absolute path, so that the extension can be upgraded from anywhere.
+		return filepath.Abs(repo)
+	}
+	if parts := strings.Split(repo, "/"); len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return "https://github.com/" + repo, nil
+	}
+	return "", fmt.Errorf("invalid repository %q: use a URL, a local path or OWNER/REPO", repo)
+}
+
+// nameOf returns the extension name from the executable or the directory name.
+func nameOf(file string) (string, bool) {
+	if runtime.GOOS == "windows" {
+		file = strings.TrimSuffix(file, ".exe")
+	}
+	name, ok := strings.CutPrefix(file, Prefix)
+	return name, ok && validName(name)
+}
+
+func validName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, `/\`) && !strings.HasPrefix(name, "-") && !strings.HasPrefix(name, ".")
+}
+
+// executable returns the path of the executable file in the directory, if any.
+func executable(dir, file string) (string, bool) {
+	if dir == "" {
+		return "", false
+	}
+	path := filepath.Join(dir, file)
+	if runtime.GOOS == "windows" && !strings.HasSuffix(path, ".exe") {
+		path += ".exe"
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return "", false
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o111 == 0 {
+		return "", false
+	}
+	return path, true
+}
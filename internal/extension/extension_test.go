@@ -0,0 +1,235 @@
+package extension
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRepo creates a git repository with the given executable script.
+func newRepo(t *testing.T, name, script string) string {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	git(t, dir, "init", "--quiet")
+	if script != "" {
+		commit(t, dir, name, script)
+	} else {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# "+name), 0o644))
+		git(t, dir, "add", ".")
+		git(t, dir, "commit", "--quiet", "-m", "init")
+	}
+	return dir
+}
+
+func commit(t *testing.T, dir, file, script string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(script), 0o755))
+	git(t, dir, "add", ".")
+	git(t, dir, "commit", "--quiet", "-m", "update "+file)
+}
+
+func git(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	args = append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func newTestManager(t *testing.T, pathDirs ...string) *Manager {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("extensions are shell scripts in tests")
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	m := NewManager(filepath.Join(t.TempDir(), "extensions"))
+	m.pathDirs = pathDirs
+	return m
+}
+
+func TestManagerInstallUpgradeRemove(t *testing.T) {
+	t.Parallel()
+
+	m := newTestManager(t)
+	repo := newRepo(t, "jira-tempo", "#!/bin/sh\necho v1\n")
+
+	ext, err := m.Install(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "tempo", ext.Name)
+	assert.Equal(t, SourceInstalled, ext.Source)
+	assert.Equal(t, filepath.Join(m.Dir(), "jira-tempo", "jira-tempo"), ext.Path)
+	assert.Equal(t, repo, ext.URL)
+	assert.NotEmpty(t, ext.Version)
+
+	_, err = m.Install(repo)
+	assert.EqualError(t, err, `extension "tempo" is already installed`)
+
+	found, err := m.Find("tempo")
+	require.NoError(t, err)
+	assert.Equal(t, ext.Path, found.Path)
+
+	_, upgraded, err := m.Upgrade("tempo")
+	require.NoError(t, err)
+	assert.False(t, upgraded)
+
+	commit(t, repo, "jira-tempo", "#!/bin/sh\necho v2\n")
+
+	newExt, upgraded, err := m.Upgrade("tempo")
+	require.NoError(t, err)
+	assert.True(t, upgraded)
+	assert.NotEqual(t, ext.Version, newExt.Version)
+
+	out, err := exec.Command(newExt.Path).Output()
+	require.NoError(t, err)
+	assert.Equal(t, "v2\n", string(out))
+
+	require.NoError(t, m.Remove("tempo"))
+	_, err = m.Find("tempo")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, m.Remove("tempo"), ErrNotFound)
+}
+
+func TestManagerInstallErrors(t *testing.T) {
+	t.Parallel()
+
+	m := newTestManager(t)
+
+	_, err := m.Install(newRepo(t, "tempo", "#!/bin/sh\n"))
+	assert.EqualError(t, err, `repository name must start with "jira-"`)
+
+	_, err = m.Install(newRepo(t, "jira-dashboard", ""))
+	assert.EqualError(t, err, "repository doesn't have an executable jira-dashboard in its root")
+	assert.NoDirExists(t, filepath.Join(m.Dir(), "jira-dashboard"))
+
+	missing := filepath.Join(t.TempDir(), "jira-missing")
+	_, err = m.Install(missing)
+	assert.EqualError(t, err, `invalid repository "`+missing+`": use a URL, a local path or OWNER/REPO`)
+}
+
+func TestManagerFindAndList(t *testing.T) {
+	t.Parallel()
+
+	bin := t.TempDir()
+	for file, mode := range map[string]os.FileMode{
+		"jira-compliance": 0o755,
+		"jira-tempo":      0o755,
+		"jira-notes.txt":  0o644,
+		"jira-":           0o755,
+		"other":           0o755,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(bin, file), []byte("#!/bin/sh\n"), mode))
+	}
+
+	m := newTestManager(t, bin)
+	_, err := m.Install(newRepo(t, "jira-tempo", "#!/bin/sh\n"))
+	require.NoError(t, err)
+
+	ext, err := m.Find("tempo")
+	require.NoError(t, err)
+	assert.Equal(t, SourceInstalled, ext.Source)
+
+	ext, err = m.Find("compliance")
+	require.NoError(t, err)
+	assert.Equal(t, SourcePath, ext.Source)
+	assert.Equal(t, filepath.Join(bin, "jira-compliance"), ext.Path)
+
+	for _, name := range []string{"notes.txt", "", "../compliance", "unknown"} {
+		_, err = m.Find(name)
+		assert.ErrorIs(t, err, ErrNotFound, name)
+	}
+
+	_, _, err = m.Upgrade("compliance")
+	assert.ErrorIs(t, err, ErrNotInstalled)
+	assert.ErrorIs(t, m.Remove("compliance"), ErrNotInstalled)
+
+	list, err := m.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "compliance", list[0].Name)
+	assert.Equal(t, SourcePath, list[0].Source)
+	assert.Equal(t, "tempo", list[1].Name)
+	assert.Equal(t, SourceInstalled, list[1].Source)
+	assert.NotEmpty(t, list[1].Version)
+}
+
+func TestRepoURL(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		repo     string
+		expected string
+		err      bool
+	}{
+		{repo: "https://github.com/acme/jira-tempo.git", expected: "https://github.com/acme/jira-tempo.git"},
+		{repo: "git@github.com:acme/jira-tempo.git", expected: "git@github.com:acme/jira-tempo.git"},
+		{repo: "acme/jira-tempo", expected: "https://github.com/acme/jira-tempo"},
+		{repo: "", err: true},
+		{repo: "jira-tempo", err: true},
+		{repo: "a/b/c", err: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.repo, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := repoURL(tc.repo)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRepoName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		repo     string
+		expected string
+		err      bool
+	}{
+		{repo: "https://github.com/acme/jira-tempo.git", expected: "tempo"},
+		{repo: "https://git.example.com/tools/jira-compliance/", expected: "compliance"},
+		{repo: "git@example.com:jira-dashboard.git", expected: "dashboard"},
+		{repo: "acme/jira-tempo", expected: "tempo"},
+		{repo: "./jira-hello", expected: "hello"},
+		{repo: "acme/tempo", err: true},
+		{repo: "acme/jira-", err: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.repo, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := RepoName(tc.repo)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -2,10 +2,13 @@ package query
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jql"
 )
 
@@ -14,22 +17,70 @@ type Issue struct {
 	Project string
 	Flags   FlagParser
 
-	params *IssueParams
+	params       *IssueParams
+	customFields []jira.IssueTypeField
+	epicField    string
+	custom       []customFilter
+}
+
+// IssueOption is a functional option to configure the issue query.
+type IssueOption func(*Issue)
+
+type customFilter struct {
+	field string
+	value string
 }
 
 const defaultLimit = 100
 
+var customFieldID = regexp.MustCompile(`^(?:customfield_(\d+)|cf\[(\d+)\])$`)
+
+// AnyGroups are the filters that can be combined with OR operator using --any flag.
+var AnyGroups = []string{
+	"history", "watching", "type", "resolution", "priority", "reporter", "assignee",
+	"component", "parent", "label", "status", "created", "updated", "due", "sprint",
+	"fix-version", "affects-version", "epic", "team", "custom", "text",
+}
+
 // NewIssue creates and initializes a new Issue type.
-func NewIssue(project string, flags FlagParser) (*Issue, error) {
+func NewIssue(project string, flags FlagParser, opts ...IssueOption) (*Issue, error) {
 	ip := IssueParams{}
 	if err := ip.init(flags); err != nil {
 		return nil, err
 	}
-	return &Issue{
+
+	i := Issue{
 		Project: project,
 		Flags:   flags,
 		params:  &ip,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(&i)
+	}
+
+	custom, err := i.resolveCustomFilters()
+	if err != nil {
+		return nil, err
+	}
+	i.custom = custom
+
+	return &i, nil
+}
+
+// WithCustomFields sets the custom fields configured for the project.
+// They are used to resolve the fields of --custom and --team filters.
+func WithCustomFields(fields []jira.IssueTypeField) IssueOption {
+	return func(i *Issue) {
+		i.customFields = fields
+	}
+}
+
+// WithEpicLinkField sets the epic link custom field, eg: customfield_10014.
+// The --epic filter uses the parent field if it is not set.
+func WithEpicLinkField(field string) IssueOption {
+	return func(i *Issue) {
+		i.epicField = field
+	}
 }
 
 func splitPositiveNegative(labels []string) ([]string, []string) {
@@ -55,7 +106,10 @@ func (i *Issue) Get() string {
 		}
 	}()
 
-	q, obf := jql.NewJQL(i.Project), i.params.OrderBy
+	// The order is validated when the params are initialized.
+	orderBy, _ := parseOrderBy(i.params.OrderBy)
+
+	q, obf := jql.NewJQL(i.Project), orderBy[0].field
 	if obf == "created" &&
 		(i.params.Updated != "" || i.params.UpdatedBefore != "" || i.params.UpdatedAfter != "") &&
 		(i.params.Created == "" && i.params.CreatedBefore == "" && i.params.CreatedAfter == "") {
@@ -67,48 +121,93 @@ func (i *Issue) Get() string {
 	}
 
 	q.And(func() {
+		// Filters passed with --any flag are combined with OR in a separate group.
+		g := jql.NewGroup()
+		at := func(name string) *jql.JQL {
+			for _, a := range i.params.Any {
+				if a == name {
+					return g
+				}
+			}
+			return q
+		}
+
 		if i.params.Latest {
-			q.History()
+			at("history").History()
 			obf = "lastViewed"
 		}
 		if i.params.Watching {
-			q.Watching()
+			at("watching").Watching()
 		}
 
-		q.FilterBy("type", i.params.IssueType).
-			FilterBy("resolution", i.params.Resolution).
-			FilterBy("priority", i.params.Priority).
-			FilterBy("reporter", i.params.Reporter).
-			FilterBy("assignee", i.params.Assignee).
-			FilterBy("component", i.params.Component).
-			FilterBy("parent", i.params.Parent)
+		at("type").FilterBy("type", i.params.IssueType)
+		at("resolution").FilterBy("resolution", i.params.Resolution)
+		at("priority").FilterBy("priority", i.params.Priority)
+		at("reporter").FilterBy("reporter", i.params.Reporter)
+		at("assignee").FilterBy("assignee", i.params.Assignee)
+		at("component").FilterBy("component", i.params.Component)
+		at("parent").FilterBy("parent", i.params.Parent)
 
-		i.setCreatedFilters(q)
-		i.setUpdatedFilters(q)
+		i.setCreatedFilters(at("created"))
+		i.setUpdatedFilters(at("updated"))
 
 		positive, negative := splitPositiveNegative(i.params.Labels)
 		if len(positive) > 0 {
-			q.In("labels", positive...)
+			at("label").In("labels", positive...)
 		}
 
 		if len(negative) > 0 {
-			q.NotIn("labels", negative...)
+			at("label").NotIn("labels", negative...)
 		}
 
 		positive, negative = splitPositiveNegative(i.params.Status)
 		if len(positive) > 0 {
-			q.In("status", positive...)
+			at("status").In("status", positive...)
 		}
 
 		if len(negative) > 0 {
-			q.NotIn("status", negative...)
+			at("status").NotIn("status", negative...)
 		}
+
+		i.setDueFilters(at("due"))
+		i.setSprintFilter(at("sprint"))
+
+		at("fix-version").FilterBy("fixVersion", i.params.FixVersion)
+		at("affects-version").FilterBy("affectedVersion", i.params.AffectsVersion)
+
+		if i.params.Epic != "" {
+			field := "parent"
+			if i.epicField != "" {
+				field = fieldRef(i.epicField)
+			}
+			at("epic").FilterBy(field, i.params.Epic)
+		}
+		if i.params.Team != "" {
+			at("team").FilterBy(i.teamField(), i.params.Team)
+		}
+		for _, c := range i.custom {
+			at("custom").FilterBy(c.field, c.value)
+		}
+
+		i.setTextFilter(at("text"))
+
+		g.Or(func() {})
+		q.Group(g)
 	})
 
+	dir := jql.DirectionDescending
 	if i.params.Reverse {
-		q.OrderBy(obf, jql.DirectionAscending)
-	} else {
-		q.OrderBy(obf, jql.DirectionDescending)
+		dir = jql.DirectionAscending
+	}
+	for n, f := range orderBy {
+		field, d := f.field, f.dir
+		if n == 0 {
+			field = obf
+		}
+		if d == "" {
+			d = dir
+		}
+		q.ThenBy(field, d)
 	}
 
 	return q.String()
@@ -119,6 +218,132 @@ func (i *Issue) Params() *IssueParams {
 	return i.params
 }
 
+func (i *Issue) resolveCustomFilters() ([]customFilter, error) {
+	keys := make([]string, 0, len(i.params.Custom))
+	for k := range i.params.Custom {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	filters := make([]customFilter, 0, len(keys))
+	for _, k := range keys {
+		field, ok := i.customField(k)
+		if !ok {
+			return nil, fmt.Errorf(
+				"unknown custom field %q: make sure it is configured in issue.fields.custom, or use its id, eg: customfield_10016", k,
+			)
+		}
+		filters = append(filters, customFilter{field: field, value: i.params.Custom[k]})
+	}
+	return filters, nil
+}
+
+// customField resolves the name of the custom field to the field to use in the query.
+// The name is matched the same way as in the --custom flag of the create command,
+// eg: story-points for "Story Points".
+func (i *Issue) customField(name string) (string, bool) {
+	if customFieldID.MatchString(name) {
+		return fieldRef(name), true
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, f := range i.customFields {
+		identifier := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(f.Name)), " ", "-")
+		if identifier == name || strings.ToLower(f.Name) == name {
+			return fieldRef(f.Key), true
+		}
+	}
+	return "", false
+}
+
+func (i *Issue) teamField() string {
+	if f, ok := i.customField("team"); ok {
+		return f
+	}
+	return "team"
+}
+
+// fieldRef converts custom field id like customfield_10016 to cf[10016].
+// Other fields are returned as is.
+func fieldRef(field string) string {
+	m := customFieldID.FindStringSubmatch(field)
+	if m == nil {
+		return field
+	}
+	return fmt.Sprintf("cf[%s%s]", m[1], m[2])
+}
+
+func (i *Issue) setSprintFilter(q *jql.JQL) {
+	switch strings.ToLower(i.params.Sprint) {
+	case "":
+	case "current":
+		q.InFunc("sprint", "openSprints()")
+	case "open":
+		g := jql.NewGroup()
+		g.Or(func() {
+			g.InFunc("sprint", "openSprints()").InFunc("sprint", "futureSprints()")
+		})
+		q.Group(g)
+	case "future":
+		q.InFunc("sprint", "futureSprints()")
+	case "closed":
+		q.InFunc("sprint", "closedSprints()")
+	default:
+		q.FilterBy("sprint", i.params.Sprint)
+	}
+}
+
+// setDueFilters sets the due date filters. Both --due and --due-before
+// constraints are applied if both are set.
+func (i *Issue) setDueFilters(q *jql.JQL) {
+	switch due := i.params.Due; due {
+	case "":
+	case "overdue":
+		q.Lt("due", "startOfDay()", false)
+	case "today", "week", "month", "year":
+		period := strings.ToUpper(due[:1]) + due[1:]
+		if due == "today" {
+			period = "Day"
+		}
+		q.Gte("due", "startOf"+period+"()", false).
+			Lte("due", "endOf"+period+"()", false)
+	default:
+		if dt, format, ok := isValidDate(due); ok {
+			q.Gte("due", due, true).Lt("due", addDay(dt, format), true)
+		} else {
+			// A period like 7d, ie: due in the next 7 days.
+			q.Lte("due", due, true)
+		}
+	}
+
+	if i.params.DueBefore != "" {
+		q.Lt("due", i.params.DueBefore, true)
+	}
+}
+
+func (i *Issue) setTextFilter(q *jql.JQL) {
+	if i.params.Text == "" {
+		return
+	}
+
+	fields := i.params.TextFields
+	if len(fields) == 0 {
+		fields = []string{"text"}
+	}
+	if len(fields) == 1 {
+		q.Contains(fields[0], i.params.Text)
+		return
+	}
+
+	g := jql.NewGroup()
+	g.Or(func() {
+		for _, f := range fields {
+			g.Contains(f, i.params.Text)
+		}
+	})
+	q.Group(g)
+}
+
 func (*Issue) setDateFilters(q *jql.JQL, field, value string) {
 	switch value {
 	case "today":
@@ -190,6 +415,18 @@ type IssueParams struct {
 	Limit         uint
 	JQL           string
 
+	Sprint         string
+	FixVersion     string
+	AffectsVersion string
+	Due            string
+	DueBefore      string
+	Epic           string
+	Team           string
+	Custom         map[string]string
+	Text           string
+	TextFields     []string
+	Any            []string
+
 	debug bool
 }
 
@@ -200,7 +437,8 @@ func (ip *IssueParams) init(flags FlagParser) error {
 	stringParams := []string{
 		"resolution", "type", "parent", "priority", "reporter", "assignee", "component",
 		"created", "created-after", "created-before", "updated", "updated-after", "updated-before",
-		"jql", "order-by", "paginate",
+		"jql", "order-by", "paginate", "sprint", "fix-version", "affects-version", "due", "due-before",
+		"epic", "team", "text", "text-fields", "any",
 	}
 
 	boolParamsMap := make(map[string]bool)
@@ -236,13 +474,37 @@ func (ip *IssueParams) init(flags FlagParser) error {
 		return err
 	}
 
+	custom, err := flags.GetStringToString("custom")
+	if err != nil {
+		return err
+	}
+
 	ip.setBoolParams(boolParamsMap)
 	ip.setStringParams(stringParamsMap)
+	ip.Custom = custom
 	ip.Labels = labels
 	ip.Status = status
 	ip.From = from
 	ip.Limit = limit
 
+	if _, err := parseOrderBy(ip.OrderBy); err != nil {
+		return err
+	}
+	return ip.validateAny()
+}
+
+func (ip *IssueParams) validateAny() error {
+	for _, a := range ip.Any {
+		valid := false
+		for _, g := range AnyGroups {
+			if a == g {
+				valid = true
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid filter %q for --any, accepts: %s", a, strings.Join(AnyGroups, ", "))
+		}
+	}
 	return nil
 }
 
@@ -294,8 +556,65 @@ func (ip *IssueParams) setStringParams(paramsMap map[string]string) {
 			ip.JQL = v
 		case "order-by":
 			ip.OrderBy = v
+		case "sprint":
+			ip.Sprint = v
+		case "fix-version":
+			ip.FixVersion = v
+		case "affects-version":
+			ip.AffectsVersion = v
+		case "due":
+			ip.Due = v
+		case "due-before":
+			ip.DueBefore = v
+		case "epic":
+			ip.Epic = v
+		case "team":
+			ip.Team = v
+		case "text":
+			ip.Text = v
+		case "text-fields":
+			ip.TextFields = splitList(v)
+		case "any":
+			ip.Any = splitList(v)
+		}
+	}
+}
+
+type orderField struct {
+	field string
+	dir   string
+}
+
+// parseOrderBy parses comma separated list of fields with
+// an optional direction, eg: priority:desc,created.
+func parseOrderBy(orderBy string) ([]orderField, error) {
+	fields := make([]orderField, 0)
+	for _, f := range splitList(orderBy) {
+		field, dir, _ := strings.Cut(f, ":")
+		field, dir = strings.TrimSpace(field), strings.ToUpper(strings.TrimSpace(dir))
+
+		if field == "" {
+			return nil, fmt.Errorf("invalid argument for order-by: missing field name in %q", f)
+		}
+		if dir != "" && dir != jql.DirectionAscending && dir != jql.DirectionDescending {
+			return nil, fmt.Errorf("invalid argument for order-by: direction of %q must be either asc or desc", field)
+		}
+		fields = append(fields, orderField{field: field, dir: dir})
+	}
+	if len(fields) == 0 {
+		fields = append(fields, orderField{field: "created"})
+	}
+	return fields, nil
+}
+
+func splitList(s string) []string {
+	out := make([]string, 0)
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
 		}
 	}
+	return out
 }
 
 func isValidDate(date string) (time.Time, string, bool) {
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 type issueParamsErr struct {
@@ -35,6 +37,8 @@ type issueFlagParser struct {
 	updatedBefore string
 	jql           string
 	orderBy       string
+	filters       map[string]string
+	custom        map[string]string
 }
 
 func (tfp *issueFlagParser) GetBool(name string) (bool, error) {
@@ -76,9 +80,16 @@ func (tfp *issueFlagParser) GetString(name string) (string, error) {
 	if name == "jql" {
 		return tfp.jql, nil
 	}
+	switch name {
+	case "sprint", "fix-version", "affects-version", "due", "due-before", "epic", "team", "text", "text-fields", "any":
+		return tfp.filters[name], nil
+	}
 	if tfp.orderBy == "" && name == "order-by" {
 		return "created", nil
 	}
+	if tfp.orderBy != "" && name == "order-by" {
+		return tfp.orderBy, nil
+	}
 	if strings.HasPrefix(name, "created") {
 		if tfp.withCreated {
 			switch name {
@@ -120,9 +131,11 @@ func (tfp *issueFlagParser) GetStringArray(name string) ([]string, error) {
 	return tfp.labels, nil
 }
 
-func (*issueFlagParser) GetStringToString(string) (map[string]string, error) { return nil, nil }
-func (*issueFlagParser) GetUint(string) (uint, error)                        { return 100, nil }
-func (*issueFlagParser) Set(string, string) error                            { return nil }
+func (tfp *issueFlagParser) GetStringToString(string) (map[string]string, error) {
+	return tfp.custom, nil
+}
+func (*issueFlagParser) GetUint(string) (uint, error) { return 100, nil }
+func (*issueFlagParser) Set(string, string) error     { return nil }
 
 func TestIssueGet(t *testing.T) {
 	t.Parallel()
@@ -428,7 +441,7 @@ func TestIssueGet(t *testing.T) {
 				assert.NoError(t, err)
 				return i
 			},
-			expected: `project="TEST" AND summary ~ cli OR x = y AND issue IN issueHistory() AND issue IN watchedIssues() AND ` +
+			expected: `project="TEST" AND (summary ~ cli OR x = y) AND issue IN issueHistory() AND issue IN watchedIssues() AND ` +
 				`type="test" AND resolution="test" AND priority="test" AND reporter="test" ` +
 				`AND assignee="test" AND component="test" AND parent="test" ORDER BY lastViewed ASC`,
 		},
@@ -447,3 +460,151 @@ func TestIssueGet(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueGetFilters(t *testing.T) {
+	t.Parallel()
+
+	customFields := []jira.IssueTypeField{
+		{Name: "Story Points", Key: "customfield_10016"},
+		{Name: "Team", Key: "customfield_10001"},
+	}
+
+	// Only the given filters are set, the defaults of the fake flag parser are disabled.
+	parser := func(filters map[string]string, custom map[string]string, orderBy string) *issueFlagParser {
+		return &issueFlagParser{
+			noHistory:  true,
+			noWatching: true,
+			orderDesc:  true,
+			emptyType:  true,
+			filters:    filters,
+			custom:     custom,
+			orderBy:    orderBy,
+		}
+	}
+	base := `project="TEST" AND resolution="test" AND priority="test" AND reporter="test" ` +
+		`AND assignee="test" AND component="test" AND parent="test"`
+
+	cases := []struct {
+		name     string
+		flags    *issueFlagParser
+		opts     []IssueOption
+		expected string
+		err      string
+	}{
+		{
+			name:     "current sprint",
+			flags:    parser(map[string]string{"sprint": "current"}, nil, ""),
+			expected: base + ` AND sprint IN openSprints() ORDER BY created DESC`,
+		},
+		{
+			name:     "open sprints",
+			flags:    parser(map[string]string{"sprint": "open"}, nil, ""),
+			expected: base + ` AND (sprint IN openSprints() OR sprint IN futureSprints()) ORDER BY created DESC`,
+		},
+		{
+			name:     "sprint by id and versions",
+			flags:    parser(map[string]string{"sprint": "42", "fix-version": "v2.0", "affects-version": "~v1.0"}, nil, ""),
+			expected: base + ` AND sprint="42" AND fixVersion="v2.0" AND affectedVersion!="v1.0" ORDER BY created DESC`,
+		},
+		{
+			name:     "due this week",
+			flags:    parser(map[string]string{"due": "week"}, nil, ""),
+			expected: base + ` AND due>=startOfWeek() AND due<=endOfWeek() ORDER BY created DESC`,
+		},
+		{
+			name:     "due this week and before a date",
+			flags:    parser(map[string]string{"due": "week", "due-before": "2020-01-01"}, nil, ""),
+			expected: base + ` AND due>=startOfWeek() AND due<=endOfWeek() AND due<"2020-01-01" ORDER BY created DESC`,
+		},
+		{
+			name:     "overdue and before a date",
+			flags:    parser(map[string]string{"due": "overdue", "due-before": "-7d"}, nil, ""),
+			expected: base + ` AND due<startOfDay() AND due<"-7d" ORDER BY created DESC`,
+		},
+		{
+			name:     "overdue",
+			flags:    parser(map[string]string{"due": "overdue"}, nil, ""),
+			expected: base + ` AND due<startOfDay() ORDER BY created DESC`,
+		},
+		{
+			name:     "due on a date",
+			flags:    parser(map[string]string{"due": "2020-12-31"}, nil, ""),
+			expected: base + ` AND due>="2020-12-31" AND due<"2021-01-01" ORDER BY created DESC`,
+		},
+		{
+			name:     "due before",
+			flags:    parser(map[string]string{"due-before": "7d"}, nil, ""),
+			expected: base + ` AND due<"7d" ORDER BY created DESC`,
+		},
+		{
+			name:     "epic using parent",
+			flags:    parser(map[string]string{"epic": "TEST-1"}, nil, ""),
+			expected: base + ` AND parent="TEST-1" ORDER BY created DESC`,
+		},
+		{
+			name:     "epic using epic link field",
+			flags:    parser(map[string]string{"epic": "TEST-1"}, nil, ""),
+			opts:     []IssueOption{WithEpicLinkField("customfield_10014")},
+			expected: base + ` AND cf[10014]="TEST-1" ORDER BY created DESC`,
+		},
+		{
+			name:     "team and custom fields",
+			flags:    parser(map[string]string{"team": "Platform"}, map[string]string{"story-points": "3", "customfield_10020": "x"}, ""),
+			opts:     []IssueOption{WithCustomFields(customFields)},
+			expected: base + ` AND cf[10001]="Platform" AND cf[10020] IS EMPTY AND cf[10016]="3" ORDER BY created DESC`,
+		},
+		{
+			name:  "unknown custom field",
+			flags: parser(nil, map[string]string{"velocity": "3"}, ""),
+			opts:  []IssueOption{WithCustomFields(customFields)},
+			err:   `unknown custom field "velocity"`,
+		},
+		{
+			name:     "text in specific fields",
+			flags:    parser(map[string]string{"text": "login bug", "text-fields": "summary, description"}, nil, ""),
+			expected: base + ` AND (summary ~ "login bug" OR description ~ "login bug") ORDER BY created DESC`,
+		},
+		{
+			name:     "text in all fields",
+			flags:    parser(map[string]string{"text": "login"}, nil, ""),
+			expected: base + ` AND text ~ "login" ORDER BY created DESC`,
+		},
+		{
+			name:  "any groups",
+			flags: parser(map[string]string{"any": "assignee,reporter,sprint", "sprint": "open"}, nil, ""),
+			expected: `project="TEST" AND resolution="test" AND priority="test" AND component="test" AND parent="test" AND ` +
+				`(reporter="test" OR assignee="test" OR sprint IN openSprints() OR sprint IN futureSprints()) ORDER BY created DESC`,
+		},
+		{
+			name:  "invalid any group",
+			flags: parser(map[string]string{"any": "assignee,foo"}, nil, ""),
+			err:   `invalid filter "foo" for --any`,
+		},
+		{
+			name:     "multiple order by fields",
+			flags:    parser(nil, nil, "priority:asc, rank,updated:DESC"),
+			expected: base + ` ORDER BY priority ASC, rank DESC, updated DESC`,
+		},
+		{
+			name:  "invalid order direction",
+			flags: parser(nil, nil, "priority:up"),
+			err:   `direction of "priority" must be either asc or desc`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			i, err := NewIssue("TEST", tc.flags, tc.opts...)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, i.Get())
+		})
+	}
+}
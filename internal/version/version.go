@@ -17,8 +17,18 @@ var (
 	Platform        = fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
 )
 
-// Info returns version and build information.
-func Info() string {
+// Build is the version and build information.
+type Build struct {
+	Version    string `json:"version" yaml:"version"`
+	GitCommit  string `json:"gitCommit" yaml:"gitCommit"`
+	CommitDate string `json:"commitDate" yaml:"commitDate"`
+	GoVersion  string `json:"goVersion" yaml:"goVersion"`
+	Compiler   string `json:"compiler" yaml:"compiler"`
+	Platform   string `json:"platform" yaml:"platform"`
+}
+
+// Get returns version and build information.
+func Get() Build {
 	i, err := strconv.ParseInt(SourceDateEpoch, 10, 64) //nolint:gomnd
 	if err != nil {
 		panic(err)
@@ -31,8 +41,21 @@ func Info() string {
 		//     2006-01-02T15:04:05-07:00
 		commitDate = time.Unix(i, 0).UTC().Format("2006-01-02T15:04:05-07:00")
 	}
+	return Build{
+		Version:    Version,
+		GitCommit:  GitCommit,
+		CommitDate: commitDate,
+		GoVersion:  GoVersion,
+		Compiler:   Compiler,
+		Platform:   Platform,
+	}
+}
+
+// Info returns version and build information.
+func Info() string {
+	b := Get()
 	return fmt.Sprintf(
 		"(Version=%q, GitCommit=%q, CommitDate=%q, GoVersion=%q, Compiler=%q, Platform=%q)",
-		Version, GitCommit, commitDate, GoVersion, Compiler, Platform,
+		b.Version, b.GitCommit, b.CommitDate, b.GoVersion, b.Compiler, b.Platform,
 	)
 }
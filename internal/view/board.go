@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 	"text/tabwriter"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -18,6 +19,7 @@ type Board struct {
 	data   []*jira.Board
 	writer io.Writer
 	buf    *bytes.Buffer
+	output Output
 }
 
 // NewBoard initializes a board.
@@ -41,8 +43,19 @@ func WithBoardWriter(w io.Writer) BoardOption {
 	}
 }
 
+// WithBoardOutput sets a machine readable output for the board.
+func WithBoardOutput(o Output) BoardOption {
+	return func(b *Board) {
+		b.output = o
+	}
+}
+
 // Render renders the board view.
 func (b Board) Render() error {
+	if b.output.Enabled() {
+		return b.output.Render(os.Stdout, b.data)
+	}
+
 	b.printHeader()
 
 	for _, d := range b.data {
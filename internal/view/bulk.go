@@ -0,0 +1,247 @@
+package view
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const maxUserSearchResults = 100
+
+// issueBulkActions returns the actions that can be run on the issues marked in the table.
+func issueBulkActions(data *tui.TableData, project string) []tui.BulkAction {
+	client := api.DefaultClient(false)
+
+	// The actions run off the UI goroutine on a copy of the rows,
+	// so look up the columns once while the data is not being rendered.
+	var (
+		keyCol      = data.GetIndex(fieldKey)
+		statusCol   = data.GetIndex(fieldStatus)
+		assigneeCol = data.GetIndex(fieldAssignee)
+		labelsCol   = data.GetIndex(fieldLabels)
+	)
+	cell := func(row []string, c int) string {
+		if c < 0 || c >= len(row) {
+			return ""
+		}
+		return row[c]
+	}
+	// each wraps the action on a single issue to report the errors with the issue key.
+	each := func(fn func(row []string, key string) (map[int]string, error)) tui.BulkRunFunc {
+		return func(row []string) (map[int]string, error) {
+			key := cell(row, keyCol)
+			changes, err := fn(row, key)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", key, cmdutil.NormalizeJiraError(err.Error()))
+			}
+			return changes, nil
+		}
+	}
+	// set returns the change of the column to the value, if the column is displayed.
+	set := func(c int, val string) map[int]string {
+		if c == -1 {
+			return nil
+		}
+		return map[int]string{c: val}
+	}
+
+	return []tui.BulkAction{
+		{
+			Label: "Transition",
+			Options: func(rows [][]string) ([]string, error) {
+				transitions, err := api.ProxyTransitions(client, cell(rows[0], keyCol))
+				if err != nil {
+					return nil, err
+				}
+				names := make([]string, 0, len(transitions))
+				for _, t := range transitions {
+					names = append(names, t.Name)
+				}
+				return names, nil
+			},
+			Handler: func(state string) (tui.BulkRunFunc, error) {
+				return each(func(_ []string, key string) (map[int]string, error) {
+					status, err := transitionIssue(client, key, state)
+					if err != nil {
+						return nil, err
+					}
+					return set(statusCol, status), nil
+				}), nil
+			},
+		},
+		{
+			Label:  "Assign",
+			Prompt: "Assign to (name or email, x to unassign)",
+			Handler: func(name string) (tui.BulkRunFunc, error) {
+				user, def, err := resolveAssignee(client, project, name)
+				if err != nil {
+					return nil, err
+				}
+				return each(func(_ []string, key string) (map[int]string, error) {
+					if err := api.ProxyAssignIssue(client, key, user, def); err != nil {
+						return nil, err
+					}
+					assignee := ""
+					if user != nil {
+						assignee = user.DisplayName
+					}
+					return set(assigneeCol, assignee), nil
+				}), nil
+			},
+		},
+		{
+			Label:  "Add label",
+			Prompt: "Label to add",
+			Handler: func(label string) (tui.BulkRunFunc, error) {
+				if strings.ContainsAny(label, " ,") {
+					return nil, fmt.Errorf("label %q can't contain spaces or commas", label)
+				}
+				return each(func(row []string, key string) (map[int]string, error) {
+					if err := client.Edit(key, &jira.EditRequest{Labels: []string{label}}); err != nil {
+						return nil, err
+					}
+					labels := splitLabels(cell(row, labelsCol))
+					if !slices.Contains(labels, label) {
+						labels = append(labels, label)
+					}
+					return set(labelsCol, strings.Join(labels, ",")), nil
+				}), nil
+			},
+		},
+		{
+			Label:  "Remove label",
+			Prompt: "Label to remove",
+			Handler: func(label string) (tui.BulkRunFunc, error) {
+				return each(func(row []string, key string) (map[int]string, error) {
+					if err := client.Edit(key, &jira.EditRequest{Labels: []string{"-" + label}}); err != nil {
+						return nil, err
+					}
+					labels := slices.DeleteFunc(splitLabels(cell(row, labelsCol)), func(l string) bool {
+						return l == label
+					})
+					return set(labelsCol, strings.Join(labels, ",")), nil
+				}), nil
+			},
+		},
+		{
+			Label:  "Move to sprint",
+			Prompt: "Sprint ID",
+			Handler: func(id string) (tui.BulkRunFunc, error) {
+				if _, err := strconv.Atoi(id); err != nil {
+					return nil, fmt.Errorf("invalid sprint ID %q", id)
+				}
+				return each(func(_ []string, key string) (map[int]string, error) {
+					return nil, client.SprintIssuesAdd(id, key)
+				}), nil
+			},
+		},
+		{
+			Label:  "Link to epic",
+			Prompt: "Epic key",
+			Handler: func(epic string) (tui.BulkRunFunc, error) {
+				epic = cmdutil.GetJiraIssueKey(project, epic)
+				nextGen := viper.GetString("project.type") == jira.ProjectTypeNextGen
+
+				return each(func(_ []string, key string) (map[int]string, error) {
+					// Next-gen projects link the issues to the epic with the parent field.
+					if nextGen {
+						return nil, client.Edit(key, &jira.EditRequest{ParentIssueKey: epic})
+					}
+					return nil, client.EpicIssuesAdd(epic, key)
+				}), nil
+			},
+		},
+		{
+			Label: "Watch",
+			Handler: func(string) (tui.BulkRunFunc, error) {
+				me, err := client.Me()
+				if err != nil {
+					return nil, err
+				}
+				user := &jira.User{AccountID: me.AccountID, Name: me.Login}
+
+				return each(func(_ []string, key string) (map[int]string, error) {
+					return nil, api.ProxyWatchIssue(client, key, user)
+				}), nil
+			},
+		},
+	}
+}
+
+// transitionIssue moves the issue to the given state and returns the new status.
+func transitionIssue(client *jira.Client, key, state string) (string, error) {
+	transitions, err := api.ProxyTransitions(client, key)
+	if err != nil {
+		return "", err
+	}
+
+	var tr *jira.Transition
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, state) {
+			tr = t
+			break
+		}
+	}
+	if tr == nil {
+		return "", fmt.Errorf("transition %q is not available", state)
+	}
+
+	if _, err := client.Transition(key, &jira.TransitionRequest{
+		Transition: &jira.TransitionRequestData{ID: tr.ID.String(), Name: tr.Name},
+	}); err != nil {
+		return "", err
+	}
+	if tr.To != nil && tr.To.Name != "" {
+		return tr.To.Name, nil
+	}
+	return tr.Name, nil
+}
+
+// findUser finds the active user assignable in the project by the name, the display name or the email.
+func findUser(client *jira.Client, project, name string) (*jira.User, error) {
+	users, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
+		Query:      name,
+		Project:    project,
+		MaxResults: maxUserSearchResults,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var user *jira.User
+	for _, u := range users {
+		if strings.EqualFold(u.Name, name) || strings.EqualFold(u.Email, name) || strings.EqualFold(u.DisplayName, name) {
+			user = u
+			break
+		}
+	}
+	if user == nil && len(users) == 1 {
+		user = users[0]
+	}
+
+	switch {
+	case user == nil:
+		return nil, fmt.Errorf("user %q not found", name)
+	case !user.Active:
+		return nil, fmt.Errorf("user %q is not active", name)
+	}
+	return user, nil
+}
+
+func splitLabels(s string) []string {
+	var labels []string
+	for _, l := range strings.Split(s, ",") {
+		if l = strings.TrimSpace(l); l != "" {
+			labels = append(labels, l)
+		}
+	}
+	return labels
+}
@@ -0,0 +1,79 @@
+package view
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestFindUser(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/user/assignable/search", r.URL.Path)
+		assert.Equal(t, "TEST", r.URL.Query().Get("project"))
+
+		var resp string
+		switch r.URL.Query().Get("query") {
+		case "ana", "ana@example.com":
+			resp = `[
+				{"accountId": "a1", "displayName": "Ana Silva", "emailAddress": "ana@example.com", "active": true},
+				{"accountId": "a2", "displayName": "Anand Rao", "emailAddress": "anand@example.com", "active": true}
+			]`
+		case "john":
+			resp = `[{"accountId": "j1", "displayName": "John Doe", "emailAddress": "john@example.com", "active": true}]`
+		case "old":
+			resp = `[{"accountId": "o1", "displayName": "Old", "emailAddress": "old@example.com", "active": false}]`
+		default:
+			resp = `[]`
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(resp))
+	}))
+	t.Cleanup(server.Close)
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	cases := []struct {
+		name    string
+		query   string
+		account string
+		err     string
+	}{
+		{name: "it finds the user by email", query: "ana@example.com", account: "a1"},
+		{name: "it finds the single match", query: "john", account: "j1"},
+		{name: "it fails for multiple partial matches", query: "ana", err: `user "ana" not found`},
+		{name: "it fails for inactive user", query: "old", err: `user "old" is not active`},
+		{name: "it fails for unknown user", query: "nobody", err: `user "nobody" not found`},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			user, err := findUser(client, "TEST", tc.query)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.account, user.AccountID)
+		})
+	}
+}
+
+func TestSplitLabels(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, splitLabels(""))
+	assert.Equal(t, []string{"a", "b"}, splitLabels("a, ,b,"))
+}
@@ -0,0 +1,122 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	relationParent    = "parent"
+	relationSubtask   = "subtask"
+	relationEpicChild = "epic child"
+
+	// maxEpicChildren is the number of the issues in an epic listed in the drill-down view.
+	maxEpicChildren = 50
+)
+
+// drillFunc returns a func that fetches the issue and its related issues to show in the drill-down view.
+func drillFunc(server string, numComments uint, mdRenderer func(int) (*glamour.TermRenderer, error)) tui.DrillFunc {
+	return func(key string, width int) (*tui.DrillItem, error) {
+		client := api.DefaultClient(false)
+
+		iss, err := api.ProxyGetIssue(client, key, issue.NewNumCommentsFilter(numComments))
+		if err != nil {
+			return nil, err
+		}
+		r, err := mdRenderer(width)
+		if err != nil {
+			return nil, err
+		}
+		out, err := Issue{
+			Server:  server,
+			Data:    iss,
+			Options: IssueOption{NumComments: numComments},
+		}.RenderedOut(r)
+		if err != nil {
+			return nil, err
+		}
+
+		links := issueRelations(iss)
+		if isEpic(iss) {
+			children, err := epicChildren(client, key)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch the issues in the epic: %w", err)
+			}
+			links = append(links, relations(relationEpicChild, children)...)
+		}
+
+		return &tui.DrillItem{Text: out, Links: links}, nil
+	}
+}
+
+// issueRelations returns the parent, the subtasks and the linked issues of the issue.
+func issueRelations(iss *jira.Issue) []tui.DrillLink {
+	var links []tui.DrillLink
+
+	if p := iss.Fields.Parent; p != nil && p.Key != "" {
+		links = append(links, tui.DrillLink{Relation: relationParent, Key: p.Key})
+	}
+	for i := range iss.Fields.Subtasks {
+		links = append(links, relation(relationSubtask, &iss.Fields.Subtasks[i]))
+	}
+	for _, link := range iss.Fields.IssueLinks {
+		switch {
+		case link.InwardIssue != nil:
+			links = append(links, relation(link.LinkType.Inward, link.InwardIssue))
+		case link.OutwardIssue != nil:
+			links = append(links, relation(link.LinkType.Outward, link.OutwardIssue))
+		}
+	}
+	return links
+}
+
+func relations(rel string, issues []*jira.Issue) []tui.DrillLink {
+	links := make([]tui.DrillLink, 0, len(issues))
+	for _, iss := range issues {
+		links = append(links, relation(rel, iss))
+	}
+	return links
+}
+
+func relation(rel string, iss *jira.Issue) tui.DrillLink {
+	return tui.DrillLink{
+		Relation: rel,
+		Key:      iss.Key,
+		Summary:  prepareTitle(iss.Fields.Summary),
+		Status:   iss.Fields.Status.Name,
+	}
+}
+
+func isEpic(iss *jira.Issue) bool {
+	name := iss.Fields.IssueType.Handle
+	if name == "" {
+		name = iss.Fields.IssueType.Name
+	}
+	return strings.EqualFold(name, jira.IssueTypeEpic)
+}
+
+// epicChildren fetches the issues in the epic. The issues of an epic in
+// a next-gen project are searched by the parent.
+func epicChildren(client *jira.Client, key string) ([]*jira.Issue, error) {
+	var (
+		res *jira.SearchResult
+		err error
+	)
+	if viper.GetString("project.type") == jira.ProjectTypeNextGen {
+		res, err = api.ProxySearch(client, fmt.Sprintf("parent = %s", key), 0, maxEpicChildren)
+	} else {
+		res, err = client.EpicIssues(key, "", 0, maxEpicChildren)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.Issues, nil
+}
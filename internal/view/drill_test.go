@@ -0,0 +1,68 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+func TestIssueRelations(t *testing.T) {
+	t.Parallel()
+
+	iss := &jira.Issue{Key: "TEST-2"}
+	iss.Fields.Parent = &struct {
+		Key string `json:"key"`
+	}{Key: "TEST-1"}
+
+	subtask := jira.Issue{Key: "TEST-3"}
+	subtask.Fields.Summary = "Subtask summary"
+	subtask.Fields.Status.Name = "To Do"
+	iss.Fields.Subtasks = []jira.Issue{subtask}
+
+	blocks := &jira.Issue{Key: "TEST-4"}
+	blocks.Fields.Status.Name = "Done"
+	blockedBy := &jira.Issue{Key: "TEST-5"}
+	blockedBy.Fields.Summary = "Blocker"
+
+	iss.Fields.IssueLinks = make([]struct {
+		ID       string `json:"id"`
+		LinkType struct {
+			Name    string `json:"name"`
+			Inward  string `json:"inward"`
+			Outward string `json:"outward"`
+		} `json:"type"`
+		InwardIssue  *jira.Issue `json:"inwardIssue,omitempty"`
+		OutwardIssue *jira.Issue `json:"outwardIssue,omitempty"`
+	}, 3)
+	for i := range iss.Fields.IssueLinks {
+		iss.Fields.IssueLinks[i].LinkType.Inward = "is blocked by"
+		iss.Fields.IssueLinks[i].LinkType.Outward = "blocks"
+	}
+	iss.Fields.IssueLinks[0].OutwardIssue = blocks
+	iss.Fields.IssueLinks[1].InwardIssue = blockedBy
+
+	expected := []tui.DrillLink{
+		{Relation: "parent", Key: "TEST-1"},
+		{Relation: "subtask", Key: "TEST-3", Summary: "Subtask summary", Status: "To Do"},
+		{Relation: "blocks", Key: "TEST-4", Status: "Done"},
+		{Relation: "is blocked by", Key: "TEST-5", Summary: "Blocker"},
+	}
+	assert.Equal(t, expected, issueRelations(iss))
+	assert.Empty(t, issueRelations(&jira.Issue{Key: "TEST-6"}))
+}
+
+func TestIsEpic(t *testing.T) {
+	t.Parallel()
+
+	epic := &jira.Issue{Fields: jira.IssueFields{IssueType: jira.IssueType{Name: "Epos", Handle: "Epic"}}}
+	assert.True(t, isEpic(epic))
+
+	epic = &jira.Issue{Fields: jira.IssueFields{IssueType: jira.IssueType{Name: "epic"}}}
+	assert.True(t, isEpic(epic))
+
+	story := &jira.Issue{Fields: jira.IssueFields{IssueType: jira.IssueType{Name: "Story"}}}
+	assert.False(t, isEpic(story))
+}
@@ -2,6 +2,7 @@ package view
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -9,8 +10,8 @@ import (
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
-// EpicIssueFunc provides issues for the epic.
-type EpicIssueFunc func(string) []*jira.Issue
+// EpicIssueFunc provides a pager for the issues of the epic.
+type EpicIssueFunc func(string) IssuePager
 
 // EpicList is a list view for epics.
 type EpicList struct {
@@ -25,6 +26,10 @@ type EpicList struct {
 //
 //nolint:dupl
 func (el *EpicList) Render() error {
+	if el.Display.Output.Enabled() {
+		return el.Display.Output.Render(os.Stdout, el.Data)
+	}
+
 	renderer, err := MDRenderer()
 	if err != nil {
 		return err
@@ -33,7 +38,7 @@ func (el *EpicList) Render() error {
 	data := el.data()
 	view := tui.NewPreview(
 		tui.WithPreviewFooterText(fmt.Sprintf("Showing %d results for project %q", len(el.Data), el.Project)),
-		tui.WithInitialText(helpText),
+		tui.WithInitialText(previewHelpText(el.Display.TableStyle.Keymap)),
 		tui.WithSidebarSelectedFunc(navigate(el.Server)),
 		tui.WithContentTableOpts(
 			tui.WithTableStyle(el.Display.TableStyle),
@@ -71,7 +76,7 @@ func (el *EpicList) data() []tui.PreviewData {
 		Key:  "help",
 		Menu: "?",
 		Contents: func(_ string) any {
-			return helpText
+			return previewHelpText(el.Display.TableStyle.Keymap)
 		},
 	})
 	for _, issue := range el.Data {
@@ -79,8 +84,7 @@ func (el *EpicList) data() []tui.PreviewData {
 			Key:  issue.Key,
 			Menu: fmt.Sprintf("➤ %s: %s", issue.Key, prepareTitle(issue.Fields.Summary)),
 			Contents: func(key string) any {
-				issues := el.Issues(key)
-				return el.tabularize(issues)
+				return pagedContents(el.Issues(key), el.tabularize)
 			},
 		})
 	}
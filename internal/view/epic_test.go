@@ -100,11 +100,11 @@ func TestEpicData(t *testing.T) {
 		Project: "TEST",
 		Server:  "https://test.local",
 		Data:    []*jira.Issue{&epic1, &epic2},
-		Issues: func(s string) []*jira.Issue {
+		Issues: func(s string) IssuePager {
 			if s == "TEST-1" {
-				return []*jira.Issue{&issue1}
+				return &singlePage{&issue1}
 			}
-			return []*jira.Issue{&issue2, &issue1}
+			return &singlePage{&issue2, &issue1}
 		},
 	}
 
@@ -116,7 +116,7 @@ func TestEpicData(t *testing.T) {
 		{
 			Key:      "help",
 			Menu:     "?",
-			Contents: helpText,
+			Contents: previewHelpText(tui.DefaultKeymap()),
 		},
 		{
 			Key:  "TEST-1",
@@ -0,0 +1,233 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	maxUserSuggestions = 10
+
+	formAssignee  = "Assignee"
+	formComment   = "Comment"
+	formSummary   = "Summary"
+	formPriority  = "Priority"
+	formLabels    = "Labels"
+	formTimeSpent = "Time spent"
+)
+
+// issueRowActions returns the actions that edit the selected issue in the table.
+func issueRowActions(data *tui.TableData, project string) []tui.RowAction {
+	client := api.DefaultClient(false)
+
+	// The actions run off the UI goroutine on a copy of the row,
+	// so look up the columns once while the data is not being rendered.
+	var (
+		keyCol      = data.GetIndex(fieldKey)
+		assigneeCol = data.GetIndex(fieldAssignee)
+		summaryCol  = data.GetIndex(fieldSummary)
+		priorityCol = data.GetIndex(fieldPriority)
+		labelsCol   = data.GetIndex(fieldLabels)
+	)
+	cell := func(row []string, c int) string {
+		if c < 0 || c >= len(row) {
+			return ""
+		}
+		return row[c]
+	}
+	// submit wraps the action to report the errors as in the CLI commands.
+	submit := func(
+		fn func(key string, vals tui.FormValues, changes map[int]string) error,
+	) func([]string, tui.FormValues) (map[int]string, error) {
+		return func(row []string, vals tui.FormValues) (map[int]string, error) {
+			changes := make(map[int]string)
+			if err := fn(cell(row, keyCol), vals, changes); err != nil {
+				return nil, errors.New(cmdutil.NormalizeJiraError(err.Error()))
+			}
+			return changes, nil
+		}
+	}
+	// set records the change of the column to the value, if the column is displayed.
+	set := func(changes map[int]string, c int, val string) {
+		if c != -1 {
+			changes[c] = val
+		}
+	}
+	fetch := func(row []string) (*jira.Issue, error) {
+		return api.ProxyGetIssue(client, cell(row, keyCol))
+	}
+
+	return []tui.RowAction{
+		{
+			Action: tui.ActionAssign,
+			Title:  "Assign",
+			Fields: func(row []string) ([]tui.FormField, error) {
+				return []tui.FormField{{
+					Label: formAssignee,
+					Value: cell(row, assigneeCol),
+					Autocomplete: func(text string) ([]string, error) {
+						users, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
+							Query:      text,
+							Project:    project,
+							MaxResults: maxUserSuggestions,
+						})
+						if err != nil {
+							return nil, err
+						}
+						names := make([]string, 0, len(users))
+						for _, u := range users {
+							if u.Active {
+								names = append(names, u.DisplayName)
+							}
+						}
+						return names, nil
+					},
+				}}, nil
+			},
+			Submit: submit(func(key string, vals tui.FormValues, changes map[int]string) error {
+				user, def, err := resolveAssignee(client, project, vals[formAssignee])
+				if err != nil {
+					return err
+				}
+				if err := api.ProxyAssignIssue(client, key, user, def); err != nil {
+					return err
+				}
+				assignee := ""
+				if user != nil {
+					assignee = user.DisplayName
+				}
+				set(changes, assigneeCol, assignee)
+				return nil
+			}),
+		},
+		{
+			Action: tui.ActionComment,
+			Title:  "Add comment",
+			Fields: func([]string) ([]tui.FormField, error) {
+				return []tui.FormField{{Label: formComment, Multiline: true}}, nil
+			},
+			Submit: submit(func(key string, vals tui.FormValues, _ map[int]string) error {
+				if vals[formComment] == "" {
+					return errors.New("comment can't be empty")
+				}
+				return client.AddIssueComment(key, vals[formComment], false)
+			}),
+		},
+		{
+			Action: tui.ActionEdit,
+			Title:  "Edit",
+			Fields: func(row []string) ([]tui.FormField, error) {
+				iss, err := fetch(row)
+				if err != nil {
+					return nil, err
+				}
+				return []tui.FormField{
+					{Label: formSummary, Value: iss.Fields.Summary},
+					{Label: formPriority, Value: iss.Fields.Priority.Name},
+				}, nil
+			},
+			Submit: submit(func(key string, vals tui.FormValues, changes map[int]string) error {
+				if vals[formSummary] == "" {
+					return errors.New("summary can't be empty")
+				}
+				if err := client.Edit(key, &jira.EditRequest{
+					Summary:  vals[formSummary],
+					Priority: vals[formPriority],
+				}); err != nil {
+					return err
+				}
+				set(changes, summaryCol, vals[formSummary])
+				if vals[formPriority] != "" {
+					set(changes, priorityCol, vals[formPriority])
+				}
+				return nil
+			}),
+		},
+		{
+			Action: tui.ActionLabels,
+			Title:  "Labels (comma separated)",
+			Fields: func(row []string) ([]tui.FormField, error) {
+				iss, err := fetch(row)
+				if err != nil {
+					return nil, err
+				}
+				return []tui.FormField{{Label: formLabels, Value: strings.Join(iss.Fields.Labels, ",")}}, nil
+			},
+			Submit: submit(func(key string, vals tui.FormValues, changes map[int]string) error {
+				labels := splitLabels(vals[formLabels])
+				for _, l := range labels {
+					if strings.Contains(l, " ") {
+						return fmt.Errorf("label %q can't contain spaces", l)
+					}
+				}
+
+				iss, err := api.ProxyGetIssue(client, key)
+				if err != nil {
+					return err
+				}
+				edits := labelChanges(iss.Fields.Labels, labels)
+				if len(edits) == 0 {
+					return nil
+				}
+				if err := client.Edit(key, &jira.EditRequest{Labels: edits}); err != nil {
+					return err
+				}
+				set(changes, labelsCol, strings.Join(labels, ","))
+				return nil
+			}),
+		},
+		{
+			Action: tui.ActionWorklog,
+			Title:  "Log work",
+			Fields: func([]string) ([]tui.FormField, error) {
+				return []tui.FormField{
+					{Label: formTimeSpent},
+					{Label: formComment, Multiline: true},
+				}, nil
+			},
+			Submit: submit(func(key string, vals tui.FormValues, _ map[int]string) error {
+				if vals[formTimeSpent] == "" {
+					return errors.New("time spent can't be empty, eg: 2d 1h 30m")
+				}
+				return client.AddIssueWorklog(key, "", vals[formTimeSpent], vals[formComment], "")
+			}),
+		},
+	}
+}
+
+// resolveAssignee returns the user to assign the issue to, or the default
+// assignee if the issue should be unassigned.
+func resolveAssignee(client *jira.Client, project, name string) (*jira.User, string, error) {
+	switch strings.ToLower(name) {
+	case "", "x", "none":
+		return nil, jira.AssigneeNone, nil
+	}
+	user, err := findUser(client, project, name)
+	if err != nil {
+		return nil, "", err
+	}
+	return user, "", nil
+}
+
+// labelChanges returns the labels to add, and the labels to remove prefixed with '-'.
+func labelChanges(current, labels []string) []string {
+	var changes []string
+	for _, l := range labels {
+		if !slices.Contains(current, l) {
+			changes = append(changes, l)
+		}
+	}
+	for _, l := range current {
+		if !slices.Contains(labels, l) {
+			changes = append(changes, "-"+l)
+		}
+	}
+	return changes
+}
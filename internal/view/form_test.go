@@ -0,0 +1,46 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestLabelChanges(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		current  []string
+		labels   []string
+		expected []string
+	}{
+		{name: "it returns nothing if labels are unchanged", current: []string{"a", "b"}, labels: []string{"b", "a"}},
+		{name: "it adds new labels", current: []string{"a"}, labels: []string{"a", "b"}, expected: []string{"b"}},
+		{name: "it removes missing labels", current: []string{"a", "b"}, labels: []string{"b"}, expected: []string{"-a"}},
+		{name: "it adds and removes labels", current: []string{"a"}, labels: []string{"c"}, expected: []string{"c", "-a"}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, labelChanges(tc.current, tc.labels))
+		})
+	}
+}
+
+func TestResolveAssigneeUnassigns(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"", "x", "None"} {
+		user, def, err := resolveAssignee(nil, "TEST", name)
+		assert.NoError(t, err)
+		assert.Nil(t, user)
+		assert.Equal(t, jira.AssigneeNone, def)
+	}
+}
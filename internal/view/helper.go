@@ -6,59 +6,58 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"text/tabwriter"
 	"time"
 
 	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/glamour/styles"
 	"github.com/fatih/color"
 	"github.com/mgutz/ansi"
 	"github.com/rivo/tview"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/pkg/browser"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
 const (
 	wordWrap = 120
 	tabWidth = 8
-	helpText = `USAGE
+)
+
+// previewHelpText returns the help text of the explorer view with the keys of the keymap.
+func previewHelpText(km tui.Keymap) string {
+	if km == nil {
+		km = tui.DefaultKeymap()
+	}
+	return fmt.Sprintf(`USAGE
 	-----
 	
 	The layout contains 2 sections, viz: Sidebar and Contents screen.  
 	
-	You can use up and down arrow keys or 'j' and 'k' letters to navigate through the sidebar.
-	Press 'w' or Tab to toggle focus between the sidebar and the contents screen.
+	You can use %s and %s to navigate through the sidebar.
+	Press %s to toggle focus between the sidebar and the contents screen.
 	
 	On contents screen:
-	  - Use arrow keys or 'j', 'k', 'h', and 'l' letters to navigate through the issue list.
-	  - Use 'g' and 'SHIFT+G' to quickly navigate to the top and bottom respectively.
-	  - Press 'v' to view selected issue details.
-	  - Press 'c' to copy issue URL to the system clipboard.
-	  - Press 'CTRL+K' to copy issue key to the system clipboard.
+	  - Use %s, %s, %s and %s to navigate through the issue list.
+	  - Use %s and %s to quickly navigate to the top and bottom respectively.
+	  - Press %s to view selected issue details.
+	  - Press %s to copy issue URL to the system clipboard.
+	  - Press %s to copy issue key to the system clipboard.
 	  - Hit ENTER to open the selected issue in a browser.
 	
-	Press 'q' / ESC / CTRL+C to quit.`
-
-	tableHelpText = `[default]ACTIONS AVAILABLE IN THE TUI
-----------------------------
-
-* [yellow]← → ↑ ↓ / j, k, h, l[default] to navigate through the list
-* [yellow]g[default] to quickly navigate to the top of the list
-* [yellow]G[default] to quickly navigate to the bottom of the list
-* [yellow]CTRL + f[default] to scroll through a page downwards
-* [yellow]CTRL + b[default] to scroll through a page upwards
-* [yellow]v[default] to view selected issue details
-* [yellow]m[default] to move/transition selected issue
-* [yellow]CTRL + r / F5[default] to refresh the issues list
-* [yellow]ENTER[default] to open the selected issue in the browser
-* [yellow]c[default] to copy issue URL to the system clipboard
-* [yellow]CTRL + k[default] to copy issue key to the system clipboard
-* [yellow]q / ESC / CTRL + c[default] to quit the app
-* [yellow]?[default] to view this help page`
-)
+	Press %s / ESC / CTRL+C to quit.`,
+		km.Keys(tui.ActionUp), km.Keys(tui.ActionDown), km.Keys(tui.ActionFocus),
+		km.Keys(tui.ActionUp), km.Keys(tui.ActionDown), km.Keys(tui.ActionLeft), km.Keys(tui.ActionRight),
+		km.Keys(tui.ActionTop), km.Keys(tui.ActionBottom), km.Keys(tui.ActionView),
+		km.Keys(tui.ActionCopy), km.Keys(tui.ActionCopyKey), km.Keys(tui.ActionQuit),
+	)
+}
 
 // ValidIssueColumns returns valid columns for issue list.
 func ValidIssueColumns() []string {
@@ -97,6 +96,40 @@ func MDRenderer() (*glamour.TermRenderer, error) {
 	)
 }
 
+// MDRendererFunc returns a func that creates the Markdown renderers for the given width.
+//
+// The style is resolved upfront, as detecting the background color of the
+// terminal doesn't work once the interactive UI has taken over the screen.
+func MDRendererFunc() func(width int) (*glamour.TermRenderer, error) {
+	style := glamour.WithEnvironmentConfig()
+	if s := os.Getenv("GLAMOUR_STYLE"); s == "" || s == styles.AutoStyle {
+		if tui.HasDarkBackground() {
+			style = glamour.WithStandardStyle(styles.DarkStyle)
+		} else {
+			style = glamour.WithStandardStyle(styles.LightStyle)
+		}
+	}
+
+	var (
+		mu        sync.Mutex
+		renderers = make(map[int]*glamour.TermRenderer)
+	)
+	return func(width int) (*glamour.TermRenderer, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r, ok := renderers[width]; ok {
+			return r, nil
+		}
+		r, err := glamour.NewTermRenderer(style, glamour.WithWordWrap(width))
+		if err != nil {
+			return nil, err
+		}
+		renderers[width] = r
+		return r, nil
+	}
+}
+
 func formatDateTime(dt, format, tz string) string {
 	t, err := time.Parse(format, dt)
 	if err != nil {
@@ -117,6 +150,43 @@ func prepareTitle(text string) string {
 	return tview.Escape(text)
 }
 
+// formatCount formats the number with the thousands separated, eg: ~1,340 if approximated.
+func formatCount(n int, approx bool) string {
+	s := strconv.Itoa(n)
+	for i := len(s) - 3; i > 0 && s[i-1] != '-'; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	if approx {
+		s = "~" + s
+	}
+	return s
+}
+
+// pagedContents returns the first page of the issues as the contents of the
+// preview layout, or the error if it can't be fetched. The next pages, if any,
+// are fetched as the cursor reaches the last rows.
+func pagedContents(p IssuePager, tabularize func([]*jira.Issue) tui.TableData) any {
+	issues, err := p.Next()
+	if err != nil {
+		return err
+	}
+	data := tabularize(issues)
+	if !p.More() {
+		return data
+	}
+
+	return &tui.PagedTableData{
+		Data: data,
+		Next: func() (tui.TableData, bool, error) {
+			issues, err := p.Next()
+			if err != nil {
+				return nil, false, err
+			}
+			return tabularize(issues)[1:], p.More(), nil
+		},
+	}
+}
+
 func issueKeyFromTuiData(r int, d any) string {
 	var path string
 
@@ -2,12 +2,14 @@
 package view
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
 func TestFormatDateTime(t *testing.T) {
@@ -107,6 +109,32 @@ func TestPrepareTitle(t *testing.T) {
 	}
 }
 
+func TestFormatCount(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    int
+		approx   bool
+		expected string
+	}{
+		{input: 0, expected: "0"},
+		{input: 200, expected: "200"},
+		{input: 1340, approx: true, expected: "~1,340"},
+		{input: 1234567, expected: "1,234,567"},
+		{input: -1000, expected: "-1,000"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.expected, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, formatCount(tc.input, tc.approx))
+		})
+	}
+}
+
 func TestShortenAndPad(t *testing.T) {
 	t.Parallel()
 
@@ -194,3 +222,60 @@ func TestMax(t *testing.T) {
 		})
 	}
 }
+
+// singlePage is a pager with a single page of issues.
+type singlePage []*jira.Issue
+
+func (p *singlePage) Next() ([]*jira.Issue, error) { return *p, nil }
+func (p *singlePage) More() bool                   { return false }
+func (p *singlePage) Total() (int, bool)           { return len(*p), false }
+
+// pages is a pager that returns the issues one per page.
+type pages []*jira.Issue
+
+func (p *pages) Next() ([]*jira.Issue, error) {
+	iss := (*p)[0]
+	*p = (*p)[1:]
+	return []*jira.Issue{iss}, nil
+}
+func (p *pages) More() bool         { return len(*p) > 0 }
+func (p *pages) Total() (int, bool) { return 3, true }
+
+// failingPage is a pager that fails to fetch the page.
+type failingPage struct{}
+
+func (failingPage) Next() ([]*jira.Issue, error) { return nil, errors.New("timeout") }
+func (failingPage) More() bool                   { return true }
+func (failingPage) Total() (int, bool)           { return 0, false }
+
+func TestPagedContents(t *testing.T) {
+	t.Parallel()
+
+	tabularize := func(issues []*jira.Issue) tui.TableData {
+		data := tui.TableData{{"KEY"}}
+		for _, iss := range issues {
+			data = append(data, []string{iss.Key})
+		}
+		return data
+	}
+
+	single := singlePage{{Key: "TEST-1"}}
+	assert.Equal(t, tui.TableData{{"KEY"}, {"TEST-1"}}, pagedContents(&single, tabularize))
+
+	p := pages{{Key: "TEST-1"}, {Key: "TEST-2"}, {Key: "TEST-3"}}
+	paged, ok := pagedContents(&p, tabularize).(*tui.PagedTableData)
+	assert.True(t, ok)
+	assert.Equal(t, tui.TableData{{"KEY"}, {"TEST-1"}}, paged.Data)
+
+	rows, more, err := paged.Next()
+	assert.NoError(t, err)
+	assert.True(t, more)
+	assert.Equal(t, tui.TableData{{"TEST-2"}}, rows)
+
+	rows, more, err = paged.Next()
+	assert.NoError(t, err)
+	assert.False(t, more)
+	assert.Equal(t, tui.TableData{{"TEST-3"}}, rows)
+
+	assert.Equal(t, errors.New("timeout"), pagedContents(failingPage{}, tabularize))
+}
@@ -55,6 +55,10 @@ type Issue struct {
 
 // Render renders the view.
 func (i Issue) Render() error {
+	if i.Display.Output.Enabled() {
+		return i.Display.Output.Render(os.Stdout, i.Data)
+	}
+
 	if i.Display.Plain || tui.IsDumbTerminal() || tui.IsNotTTY() {
 		return i.renderPlain(os.Stdout)
 	}
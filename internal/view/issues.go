@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -24,21 +25,41 @@ type DisplayFormat struct {
 	FixedColumns uint
 	Comments     uint
 	TableStyle   tui.TableStyle
+	Layout       tui.TableLayout
+	SaveLayout   tui.LayoutSaveFunc
 	Timezone     string
+	Output       Output
+}
+
+// IssuePager fetches the issues of a list page by page.
+type IssuePager interface {
+	Next() ([]*jira.Issue, error)
+	More() bool
+	Total() (total int, approx bool)
 }
 
 // IssueList is a list view for issues.
 type IssueList struct {
-	Project    string
-	Server     string
-	Data       []*jira.Issue
-	Display    DisplayFormat
-	Refresh    tui.RefreshFunc
-	FooterText string
+	Project string
+	Server  string
+	// Data is the first page of the issues.
+	Data    []*jira.Issue
+	Display DisplayFormat
+	Refresh tui.RefreshFunc
+	// Pager, if set, fetches the next pages in the interactive view.
+	Pager IssuePager
+	// FooterContext is appended to the footer, eg: the sprint the issues are in.
+	FooterContext string
+	// Watch, if set, fetches the issues again on an interval in the interactive view.
+	Watch *Watch
 }
 
 // Render renders the view.
 func (l *IssueList) Render() error {
+	if l.Display.Output.Enabled() {
+		return l.Display.Output.Render(os.Stdout, l.Data)
+	}
+
 	// Prioritize CSV format when explicitly requested
 	if l.Display.CSV {
 		w := os.Stdout
@@ -55,21 +76,29 @@ func (l *IssueList) Render() error {
 		return l.renderPlain(w, delimeter)
 	}
 
-	renderer, err := MDRenderer()
+	mdRenderer := MDRendererFunc()
+	renderer, err := mdRenderer(wordWrap)
 	if err != nil {
 		return err
 	}
 
 	data := l.data()
-	if l.FooterText == "" {
-		l.FooterText = fmt.Sprintf("Showing %d results for project %q", len(data)-1, l.Project)
+
+	// loaded keeps the data in sync with the rows of the table as the pages are appended or the rows are replaced.
+	loaded := func(p IssuePager) tui.PageLoadedFunc {
+		return func(d tui.TableData, _ bool) string {
+			data = d
+			return l.footer(p, len(data)-1)
+		}
 	}
 
 	view := tui.NewTable(
 		tui.WithTableStyle(l.Display.TableStyle),
-		tui.WithTableFooterText(l.FooterText),
-		tui.WithTableHelpText(tableHelpText),
+		tui.WithTableFooterText(l.footer(l.Pager, len(data)-1)),
+		tui.WithPageFunc(l.nextPage(l.Pager, data[0]), loaded(l.Pager)),
+		tui.WithWatchFunc(l.watchFunc(data[0], loaded)),
 		tui.WithSelectedFunc(navigate(l.Server)),
+		tui.WithDrillFunc(drillFunc(l.Server, l.Display.Comments, mdRenderer)),
 		tui.WithViewModeFunc(func(r, c int, _ any) (func() any, func(any) (string, error)) {
 			dataFn := func() any {
 				ci := data.GetIndex(fieldKey)
@@ -86,6 +115,25 @@ func (l *IssueList) Render() error {
 			}
 			return dataFn, renderFn
 		}),
+		tui.WithDetailFunc(func(r int) (string, func() (any, error), func(any, int) (string, error)) {
+			key := data.Get(r, data.GetIndex(fieldKey))
+			fetchFn := func() (any, error) {
+				return api.ProxyGetIssue(api.DefaultClient(false), key, issue.NewNumCommentsFilter(l.Display.Comments))
+			}
+			renderFn := func(i any, width int) (string, error) {
+				r, err := mdRenderer(width)
+				if err != nil {
+					return "", err
+				}
+				iss := Issue{
+					Server:  l.Server,
+					Data:    i.(*jira.Issue),
+					Options: IssueOption{NumComments: l.Display.Comments},
+				}
+				return iss.RenderedOut(r)
+			}
+			return key, fetchFn, renderFn
+		}),
 		tui.WithCopyFunc(copyURL(l.Server)),
 		tui.WithCopyKeyFunc(copyKey()),
 		tui.WithMoveFunc(func(r, c int) func() (string, []string, tui.MoveHandlerFunc, string, tui.RefreshTableStateFunc) {
@@ -130,11 +178,76 @@ func (l *IssueList) Render() error {
 		}),
 		tui.WithRefreshFunc(l.Refresh),
 		tui.WithFixedColumns(l.Display.FixedColumns),
+		tui.WithTableLayout(l.Display.Layout, l.Display.SaveLayout),
+		tui.WithBulkActions(issueBulkActions(&data, l.Project)...),
+		tui.WithRowActions(issueRowActions(&data, l.Project)...),
 	)
 
 	return view.Paint(data)
 }
 
+// nextPage returns a func that fetches the next page of issues as the table rows, if any.
+func (l *IssueList) nextPage(p IssuePager, headers []string) tui.PageFunc {
+	if p == nil || !p.More() {
+		return nil
+	}
+	return func() (tui.TableData, bool, error) {
+		issues, err := p.Next()
+		if err != nil {
+			return nil, false, err
+		}
+		rows := make(tui.TableData, 0, len(issues))
+		for _, iss := range issues {
+			rows = append(rows, l.assignColumns(headers, iss))
+		}
+		return rows, p.More(), nil
+	}
+}
+
+// watchFunc returns the interval, the func that fetches the first page of the issues again
+// and the notification to emit on changes in the watch mode, if the list is watched.
+func (l *IssueList) watchFunc(headers []string, loaded func(IssuePager) tui.PageLoadedFunc) (time.Duration, tui.WatchFunc, string) {
+	if l.Watch == nil || l.Watch.Pager == nil {
+		return 0, nil, ""
+	}
+	return l.Watch.Interval, func() (tui.TableData, tui.PageFunc, tui.PageLoadedFunc, error) {
+		p := l.Watch.Pager()
+
+		issues, err := p.Next()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if p.More() {
+			// Fetch the total in the background as the footer is rendered in the UI.
+			p.Total()
+		}
+
+		data := tui.TableData{headers}
+		for _, iss := range issues {
+			data = append(data, l.assignColumns(headers, iss))
+		}
+		return data, l.nextPage(p, headers), loaded(p), nil
+	}, l.Watch.Notify
+}
+
+// footer returns the footer text for the number of issues shown, eg: Showing 200 of ~1,340 results.
+func (l *IssueList) footer(p IssuePager, shown int) string {
+	count := formatCount(shown, false)
+	if p != nil && p.More() {
+		if total, approx := p.Total(); total > shown {
+			count += " of " + formatCount(total, approx)
+		} else {
+			count += "+"
+		}
+	}
+
+	text := fmt.Sprintf("Showing %s results for project %q", count, l.Project)
+	if l.FooterContext != "" {
+		text += " " + l.FooterContext
+	}
+	return text
+}
+
 // renderPlain renders the issue in plain view.
 func (l *IssueList) renderPlain(w io.Writer, delimeter string) error {
 	return renderPlain(w, l.data(), delimeter)
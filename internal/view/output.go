@@ -0,0 +1,350 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+)
+
+// OutputJSONAll selects all fields in the JSON output.
+const OutputJSONAll = "all"
+
+const defaultDateLayout = "2006-01-02 15:04:05"
+
+var templateColors = map[string]color.Attribute{
+	"black":   color.FgBlack,
+	"red":     color.FgRed,
+	"green":   color.FgGreen,
+	"yellow":  color.FgYellow,
+	"blue":    color.FgBlue,
+	"magenta": color.FgMagenta,
+	"cyan":    color.FgCyan,
+	"white":   color.FgWhite,
+	"gray":    color.FgHiBlack,
+	"bold":    color.Bold,
+}
+
+// Output is a machine readable output format shared by all views.
+// It takes precedence over the interactive and plain modes if enabled.
+//
+// Both the template and the JSON output work on the JSON representation
+// of the data, so the field names are the same as in the Jira API.
+type Output struct {
+	// Template is a Go template to format the data with.
+	Template string
+	// JSON prints the data as JSON if the template is not set.
+	JSON bool
+	// Format is a structured format requested with the global --output
+	// flag, ie: json, yaml or ndjson. It takes precedence over the JSON.
+	Format string
+	// Fields limits the data to the given dot separated paths, eg: fields.status.name.
+	Fields []string
+	// Timezone is used to format dates in the template.
+	Timezone string
+}
+
+// Enabled checks if the output is requested.
+func (o Output) Enabled() bool {
+	return o.JSON || o.Template != "" || o.Format != ""
+}
+
+// Render renders the data to the writer.
+func (o Output) Render(w io.Writer, data interface{}) error {
+	val, err := toJSONValue(data)
+	if err != nil {
+		return err
+	}
+	if len(o.Fields) > 0 {
+		if val, err = selectFields(val, o.Fields); err != nil {
+			return err
+		}
+	}
+
+	if o.Template != "" {
+		tmpl, err := template.New("output").Funcs(o.funcs()).Parse(o.Template)
+		if err != nil {
+			return err
+		}
+		return tmpl.Execute(w, val)
+	}
+
+	switch o.Format {
+	case cmdutil.OutputYAML:
+		return cmdutil.Encode(w, o.Format, val)
+	case cmdutil.OutputNDJSON:
+		// Lists are written as one compact JSON object per line.
+		items, ok := val.([]interface{})
+		if !ok {
+			return cmdutil.Encode(w, o.Format, val)
+		}
+		for _, item := range items {
+			if err := cmdutil.Encode(w, o.Format, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return cmdutil.Encode(w, cmdutil.OutputJSON, val)
+}
+
+func (o Output) funcs() template.FuncMap {
+	return template.FuncMap{
+		"adf":      toMarkdown,
+		"md":       toMarkdown,
+		"date":     o.date,
+		"join":     join,
+		"color":    colorize,
+		"json":     toJSON,
+		"upper":    strings.ToUpper,
+		"lower":    strings.ToLower,
+		"truncate": truncate,
+	}
+}
+
+// date formats the Jira datetime in the configured timezone.
+// The layout is optional and defaults to "2006-01-02 15:04:05".
+func (o Output) date(dt interface{}, layout ...string) (string, error) {
+	s, ok := dt.(string)
+	if !ok || s == "" {
+		return "", nil
+	}
+
+	l := defaultDateLayout
+	if len(layout) > 0 {
+		l = layout[0]
+	}
+
+	var (
+		t   time.Time
+		err error
+	)
+	for _, format := range []string{jira.RFC3339MilliLayout, jira.RFC3339, time.RFC3339, "2006-01-02"} {
+		if t, err = time.Parse(format, s); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("date: unable to parse %q", s)
+	}
+	if o.Timezone != "" {
+		loc, err := time.LoadLocation(o.Timezone)
+		if err != nil {
+			return "", fmt.Errorf("date: %w", err)
+		}
+		t = t.In(loc)
+	}
+	return t.Format(l), nil
+}
+
+// toMarkdown converts an ADF document from the v3 API or a Jira wiki
+// markup from the v2 API to markdown. It is available as both adf and md
+// funcs, so the same template works regardless of the installation type.
+func toMarkdown(v interface{}) (string, error) {
+	switch n := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return strings.TrimSpace(md.FromJiraMD(n)), nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	var doc adf.ADF
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return "", fmt.Errorf("adf: %w", err)
+	}
+	return strings.TrimSpace(adf.NewTranslator(&doc, adf.NewMarkdownTranslator()).Translate()), nil
+}
+
+// join joins the list items with the separator. Maps with a name,
+// eg: components, are joined by their name. It is designed to be
+// used in a pipeline, eg: {{ .fields.labels | join ", " }}.
+func join(sep string, v interface{}) string {
+	items, ok := v.([]interface{})
+	if !ok {
+		if v == nil {
+			return ""
+		}
+		return fmt.Sprint(v)
+	}
+
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if m, ok := item.(map[string]interface{}); ok {
+			if name, ok := m["name"]; ok {
+				item = name
+			}
+		}
+		out = append(out, fmt.Sprint(item))
+	}
+	return strings.Join(out, sep)
+}
+
+// colorize colors the text, eg: {{ .key | color "green" }}.
+func colorize(name string, v interface{}) (string, error) {
+	attr, ok := templateColors[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf("color: unknown color %q", name)
+	}
+	return color.New(attr).Sprint(orBlank(v)), nil
+}
+
+func toJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func truncate(n int, v interface{}) string {
+	s := []rune(fmt.Sprint(orBlank(v)))
+	if len(s) <= n {
+		return string(s)
+	}
+	if n < 1 {
+		return ""
+	}
+	return string(s[:n-1]) + "…"
+}
+
+func orBlank(v interface{}) interface{} {
+	if v == nil {
+		return ""
+	}
+	return v
+}
+
+func toJSONValue(data interface{}) (interface{}, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// selectFields keeps the given paths of the value and drops everything else.
+// Lists are handled element-wise, so the fields of a list of issues can be
+// selected with the same paths as a single issue.
+func selectFields(val interface{}, fields []string) (interface{}, error) {
+	var (
+		out     interface{}
+		missing []string
+	)
+	for _, f := range fields {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		picked, ok := pick(val, strings.Split(f, "."))
+		if !ok {
+			missing = append(missing, f)
+			continue
+		}
+		out = merge(out, picked)
+	}
+
+	if len(missing) > 0 {
+		// An empty list has no fields, so there is nothing to validate against.
+		if l, ok := val.([]interface{}); !ok || len(l) > 0 {
+			sort.Strings(missing)
+			return nil, fmt.Errorf("unknown field(s): %s", strings.Join(missing, ", "))
+		}
+	}
+	if out == nil {
+		if _, ok := val.([]interface{}); ok {
+			return []interface{}{}, nil
+		}
+	}
+	return out, nil
+}
+
+// pick returns the value at the path wrapped in its parent objects.
+// It returns false if the path doesn't exist in any element.
+func pick(val interface{}, path []string) (interface{}, bool) {
+	if len(path) == 0 {
+		return val, true
+	}
+
+	switch v := val.(type) {
+	case map[string]interface{}:
+		key, sub, ok := lookup(v, path[0])
+		if !ok {
+			return nil, false
+		}
+		picked, ok := pick(sub, path[1:])
+		if !ok {
+			return nil, false
+		}
+		return map[string]interface{}{key: picked}, true
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		found := len(v) == 0
+		for i, item := range v {
+			if picked, ok := pick(item, path); ok {
+				out[i], found = picked, true
+			}
+		}
+		return out, found
+	case nil:
+		// Optional objects, eg: parent, are null if not set.
+		return nil, true
+	}
+	return nil, false
+}
+
+// lookup finds the key in the map, ignoring the case if there is no exact match.
+func lookup(m map[string]interface{}, key string) (string, interface{}, bool) {
+	if v, ok := m[key]; ok {
+		return key, v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return k, v, true
+		}
+	}
+	return "", nil, false
+}
+
+func merge(dst, src interface{}) interface{} {
+	switch s := src.(type) {
+	case map[string]interface{}:
+		d, ok := dst.(map[string]interface{})
+		if !ok {
+			return s
+		}
+		for k, v := range s {
+			d[k] = merge(d[k], v)
+		}
+		return d
+	case []interface{}:
+		d, ok := dst.([]interface{})
+		if !ok || len(d) != len(s) {
+			return s
+		}
+		for i := range s {
+			d[i] = merge(d[i], s[i])
+		}
+		return d
+	case nil:
+		return dst
+	}
+	return src
+}
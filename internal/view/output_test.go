@@ -0,0 +1,177 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func getOutputTestIssues() []*jira.Issue {
+	issues := []*jira.Issue{
+		{Key: "TEST-1"},
+		{Key: "TEST-2"},
+	}
+	issues[0].Fields.Summary = "First issue"
+	issues[0].Fields.Labels = []string{"backend", "urgent"}
+	issues[0].Fields.Status.Name = "Done"
+	issues[0].Fields.Created = "2020-12-13T14:05:20.974+0100"
+	issues[0].Fields.Description = "h1. Heading"
+	issues[1].Fields.Summary = "Second issue"
+	issues[1].Fields.Status.Name = "To Do"
+	issues[1].Fields.Created = "2020-12-13T14:05:20.974+0100"
+
+	return issues
+}
+
+func TestOutputJSONFields(t *testing.T) {
+	var b bytes.Buffer
+
+	o := Output{JSON: true, Fields: []string{"key", "fields.status.name", "FIELDS.summary"}}
+	assert.NoError(t, o.Render(&b, getOutputTestIssues()))
+
+	expected := `[
+  {
+    "fields": {
+      "status": {
+        "name": "Done"
+      },
+      "summary": "First issue"
+    },
+    "key": "TEST-1"
+  },
+  {
+    "fields": {
+      "status": {
+        "name": "To Do"
+      },
+      "summary": "Second issue"
+    },
+    "key": "TEST-2"
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestOutputJSONUnknownField(t *testing.T) {
+	var b bytes.Buffer
+
+	o := Output{JSON: true, Fields: []string{"key", "fields.nope", "foo"}}
+	assert.EqualError(t, o.Render(&b, getOutputTestIssues()), "unknown field(s): fields.nope, foo")
+
+	b.Reset()
+	assert.NoError(t, o.Render(&b, []*jira.Issue{}))
+	assert.Equal(t, "[]\n", b.String())
+}
+
+func TestOutputJSONAll(t *testing.T) {
+	var b bytes.Buffer
+
+	o := Output{JSON: true}
+	assert.NoError(t, o.Render(&b, &jira.Board{ID: 1, Name: "Board", Type: "scrum"}))
+	assert.Equal(t, "{\n  \"id\": 1,\n  \"name\": \"Board\",\n  \"type\": \"scrum\"\n}\n", b.String())
+}
+
+func TestOutputFormat(t *testing.T) {
+	var b bytes.Buffer
+
+	o := Output{Format: "ndjson", Fields: []string{"key", "fields.status.name"}}
+	assert.True(t, o.Enabled())
+	assert.NoError(t, o.Render(&b, getOutputTestIssues()))
+	assert.Equal(t, `{"fields":{"status":{"name":"Done"}},"key":"TEST-1"}
+{"fields":{"status":{"name":"To Do"}},"key":"TEST-2"}
+`, b.String())
+
+	b.Reset()
+	o = Output{Format: "yaml", Fields: []string{"key", "fields.labels"}}
+	assert.NoError(t, o.Render(&b, getOutputTestIssues()))
+	assert.Equal(t, `- fields:
+    labels:
+      - backend
+      - urgent
+  key: TEST-1
+- fields:
+    labels: null
+  key: TEST-2
+`, b.String())
+
+	b.Reset()
+	o = Output{Format: "ndjson"}
+	assert.NoError(t, o.Render(&b, &jira.Board{ID: 1, Name: "Board", Type: "scrum"}))
+	assert.Equal(t, "{\"id\":1,\"name\":\"Board\",\"type\":\"scrum\"}\n", b.String())
+}
+
+func TestOutputTemplate(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	t.Cleanup(func() { color.NoColor = noColor })
+
+	cases := []struct {
+		name     string
+		output   Output
+		expected string
+	}{
+		{
+			name:     "range with funcs",
+			output:   Output{Template: `{{range .}}{{.key | color "green"}} {{.fields.summary | upper}} [{{.fields.labels | join ","}}]{{"\n"}}{{end}}`},
+			expected: "TEST-1 FIRST ISSUE [backend,urgent]\nTEST-2 SECOND ISSUE []\n",
+		},
+		{
+			name:     "date in timezone",
+			output:   Output{Template: `{{range .}}{{date .fields.created}}|{{date .fields.created "Jan 2"}}{{"\n"}}{{end}}`, Timezone: "Asia/Kathmandu"},
+			expected: "2020-12-13 18:50:20|Dec 13\n2020-12-13 18:50:20|Dec 13\n",
+		},
+		{
+			name:     "markdown and truncate",
+			output:   Output{Template: `{{with index . 0}}{{md .fields.description}}|{{truncate 5 .fields.summary}}{{end}}`},
+			expected: "# Heading|Firs…",
+		},
+		{
+			name:     "template with projected fields",
+			output:   Output{Template: `{{json .}}`, Fields: []string{"key"}},
+			expected: `[{"key":"TEST-1"},{"key":"TEST-2"}]`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+
+			assert.NoError(t, tc.output.Render(&b, getOutputTestIssues()))
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+}
+
+func TestOutputTemplateADF(t *testing.T) {
+	var b bytes.Buffer
+
+	data := map[string]interface{}{
+		"description": map[string]interface{}{
+			"version": 1,
+			"type":    "doc",
+			"content": []interface{}{
+				map[string]interface{}{
+					"type": "paragraph",
+					"content": []interface{}{
+						map[string]interface{}{"type": "text", "text": "Hello, ADF"},
+					},
+				},
+			},
+		},
+	}
+
+	o := Output{Template: `{{adf .description}}`}
+	assert.NoError(t, o.Render(&b, data))
+	assert.Contains(t, b.String(), "Hello, ADF")
+
+	b.Reset()
+	o = Output{Template: `{{color "pink" .description}}`}
+	assert.Error(t, o.Render(&b, data))
+}
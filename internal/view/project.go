@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 	"text/tabwriter"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -18,6 +19,7 @@ type Project struct {
 	data   []*jira.Project
 	writer io.Writer
 	buf    *bytes.Buffer
+	output Output
 }
 
 // NewProject initializes a project.
@@ -41,8 +43,19 @@ func WithProjectWriter(w io.Writer) ProjectOption {
 	}
 }
 
+// WithProjectOutput sets a machine readable output for the project.
+func WithProjectOutput(o Output) ProjectOption {
+	return func(p *Project) {
+		p.output = o
+	}
+}
+
 // Render renders the project view.
 func (p Project) Render() error {
+	if p.output.Enabled() {
+		return p.output.Render(os.Stdout, p.data)
+	}
+
 	p.printHeader()
 
 	for _, d := range p.data {
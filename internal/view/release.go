@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 	"text/tabwriter"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -18,6 +19,7 @@ type Release struct {
 	data   []*jira.ProjectVersion
 	writer io.Writer
 	buf    *bytes.Buffer
+	output Output
 }
 
 // NewRelease constructs a project release command.
@@ -41,8 +43,19 @@ func WithReleaseWriter(w io.Writer) ProjectVersionOptions {
 	}
 }
 
+// WithReleaseOutput sets a machine readable output for the project release.
+func WithReleaseOutput(o Output) ProjectVersionOptions {
+	return func(r *Release) {
+		r.output = o
+	}
+}
+
 // Render renders the project release view.
 func (r Release) Render() error {
+	if r.output.Enabled() {
+		return r.output.Render(os.Stdout, r.data)
+	}
+
 	r.printHeader()
 
 	for _, d := range r.data {
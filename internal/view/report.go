@@ -0,0 +1,624 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// Units the sprint reports are measured in.
+const (
+	ReportUnitPoints = "points"
+	ReportUnitIssues = "issues"
+)
+
+const (
+	// DefaultPointsName is the name of the story points field in the changelog of the server installations.
+	DefaultPointsName = "Story Points"
+
+	statusCategoryDone = "done"
+	chartWidth         = 50
+	reportDateLayout   = "2006-01-02"
+)
+
+// PointsField identifies the story points field. The ID is used to fetch the field and to find
+// its changes in the changelog along with the name, as the server installations only set the name.
+type PointsField struct {
+	ID   string
+	Name string
+}
+
+// SprintReport holds the metrics of a sprint computed from the changelog of its issues.
+type SprintReport struct {
+	Sprint *jira.Sprint `json:"sprint"`
+	// Unit is either points, if the story points field is set, or issues.
+	Unit      string      `json:"unit"`
+	Committed ReportTotal `json:"committed"`
+	Completed ReportTotal `json:"completed"`
+	Added     ReportTotal `json:"added"`
+	Removed   ReportTotal `json:"removed"`
+	// Reestimated is the change of the story points of the committed issues during the sprint.
+	Reestimated float64         `json:"reestimated"`
+	Issues      []ReportIssue   `json:"issues"`
+	Burndown    []BurndownPoint `json:"burndown"`
+}
+
+// ReportTotal is the number of issues and the story points of a group of issues.
+type ReportTotal struct {
+	Issues int     `json:"issues"`
+	Points float64 `json:"points"`
+}
+
+// ReportIssue is an issue in a sprint report.
+type ReportIssue struct {
+	Key       string  `json:"key"`
+	Summary   string  `json:"summary"`
+	Type      string  `json:"type"`
+	Status    string  `json:"status"`
+	Points    float64 `json:"points"`
+	Committed bool    `json:"committed"`
+	Added     bool    `json:"added"`
+	Removed   bool    `json:"removed"`
+	Completed bool    `json:"completed"`
+}
+
+// BurndownPoint is the work remaining in a sprint at a time, along with the ideal remaining work.
+type BurndownPoint struct {
+	Time      time.Time `json:"time"`
+	Remaining float64   `json:"remaining"`
+	Ideal     float64   `json:"ideal"`
+}
+
+// NewSprintReport computes the report of the sprint as of now. The issues are committed if they were in
+// the sprint when it started, and completed if they were done when it was completed, or now if it is active.
+//
+// The issues removed from the sprint are counted only if they are in the given issues,
+// which is not the case for the issues fetched from Jira by the sprint.
+func NewSprintReport(sprint *jira.Sprint, issues []*jira.IssueHistory, points PointsField, now time.Time) (*SprintReport, error) {
+	start, err := parseReportTime(sprint.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("sprint %q has not started yet", sprint.Name)
+	}
+	planned, err := parseReportTime(sprint.EndDate)
+	if err != nil {
+		planned = start
+	}
+	end := now
+	if completed, err := parseReportTime(sprint.CompleteDate); err == nil {
+		end = completed
+	}
+	if end.Before(start) {
+		end = start
+	}
+
+	report := SprintReport{Sprint: sprint, Unit: ReportUnitIssues, Issues: make([]ReportIssue, 0, len(issues))}
+	if points.ID != "" {
+		report.Unit = ReportUnitPoints
+	}
+
+	timelines := make([]*issueTimeline, 0, len(issues))
+	for _, iss := range issues {
+		tl := newIssueTimeline(iss, strconv.Itoa(sprint.ID), points)
+		timelines = append(timelines, tl)
+
+		ri := ReportIssue{
+			Key:       iss.Key,
+			Summary:   iss.Fields.Summary,
+			Type:      iss.Fields.IssueType.Name,
+			Status:    iss.Fields.Status.Name,
+			Points:    iss.Points,
+			Committed: tl.inSprint(start),
+		}
+		inEnd := tl.inSprint(end)
+		ri.Added = !ri.Committed && (inEnd || tl.joinedBetween(start, end))
+		ri.Removed = (ri.Committed || ri.Added) && !inEnd
+		ri.Completed = inEnd && tl.done(end)
+
+		if ri.Committed {
+			report.Committed.add(tl.points(start))
+			if inEnd {
+				report.Reestimated += tl.points(end) - tl.points(start)
+			}
+		}
+		if ri.Added {
+			report.Added.add(tl.points(end))
+		}
+		if ri.Removed {
+			report.Removed.add(tl.points(end))
+		}
+		if ri.Completed {
+			report.Completed.add(tl.points(end))
+		}
+		report.Issues = append(report.Issues, ri)
+	}
+
+	committed := report.Committed.Amount(report.Unit)
+	for _, t := range burndownTimes(start, end) {
+		var remaining float64
+		for _, tl := range timelines {
+			if tl.inSprint(t) && !tl.done(t) {
+				remaining += tl.amount(report.Unit, t)
+			}
+		}
+		report.Burndown = append(report.Burndown, BurndownPoint{
+			Time:      t,
+			Remaining: remaining,
+			Ideal:     idealRemaining(committed, start, planned, t),
+		})
+	}
+
+	return &report, nil
+}
+
+// Amount returns the total in the given unit.
+func (r ReportTotal) Amount(unit string) float64 {
+	if unit == ReportUnitPoints {
+		return r.Points
+	}
+	return float64(r.Issues)
+}
+
+func (r *ReportTotal) add(points float64) {
+	r.Issues++
+	r.Points += points
+}
+
+// burndownTimes returns the start of the sprint, the same time on the following days and the end.
+func burndownTimes(start, end time.Time) []time.Time {
+	times := []time.Time{start}
+	for t := start.AddDate(0, 0, 1); t.Before(end); t = t.AddDate(0, 0, 1) {
+		times = append(times, t)
+	}
+	if end.After(start) {
+		times = append(times, end)
+	}
+	return times
+}
+
+// idealRemaining returns the work remaining at the time if the committed work is burnt evenly
+// until the planned end. It is rounded to two decimals as it is shown along with the remaining work.
+func idealRemaining(committed float64, start, planned, t time.Time) float64 {
+	if !planned.After(start) || !t.Before(planned) {
+		return 0
+	}
+	ideal := committed * (1 - float64(t.Sub(start))/float64(planned.Sub(start)))
+	return math.Round(ideal*100) / 100
+}
+
+// issueTimeline answers how an issue was at a time from its changelog.
+type issueTimeline struct {
+	issue    *jira.IssueHistory
+	sprintID string
+	created  time.Time
+	sprints  []timedChange // Changes of the sprints of the issue.
+	estimate []timedChange // Changes of the story points.
+	doneAt   time.Time
+}
+
+type timedChange struct {
+	at   time.Time
+	item jira.ChangelogItem
+}
+
+func newIssueTimeline(iss *jira.IssueHistory, sprintID string, points PointsField) *issueTimeline {
+	tl := issueTimeline{issue: iss, sprintID: sprintID}
+	tl.created, _ = parseReportTime(iss.Fields.Created)
+
+	var statusChanges []timedChange
+	for _, h := range iss.Changelog.Histories {
+		at, err := parseReportTime(h.Created)
+		if err != nil {
+			continue
+		}
+		for _, item := range h.Items {
+			c := timedChange{at: at, item: item}
+			switch {
+			case strings.EqualFold(item.Field, "Sprint"):
+				tl.sprints = append(tl.sprints, c)
+			case points.matches(item):
+				tl.estimate = append(tl.estimate, c)
+			case strings.EqualFold(item.Field, "status"):
+				statusChanges = append(statusChanges, c)
+			}
+		}
+	}
+	for _, changes := range [][]timedChange{tl.sprints, tl.estimate, statusChanges} {
+		slices.SortStableFunc(changes, func(a, b timedChange) int { return a.at.Compare(b.at) })
+	}
+
+	if iss.Fields.Status.StatusCategory.Key == statusCategoryDone {
+		tl.doneAt = tl.created
+		if at, err := parseReportTime(iss.Fields.ResolutionDate); err == nil {
+			tl.doneAt = at
+		}
+		// The issue is done since it last moved to the current status.
+		for _, c := range slices.Backward(statusChanges) {
+			if c.item.ToString == iss.Fields.Status.Name {
+				tl.doneAt = c.at
+				break
+			}
+		}
+	}
+
+	return &tl
+}
+
+func (p PointsField) matches(item jira.ChangelogItem) bool {
+	if p.ID == "" {
+		return false
+	}
+	if item.FieldID != "" {
+		return item.FieldID == p.ID
+	}
+	return p.Name != "" && strings.EqualFold(item.Field, p.Name)
+}
+
+// inSprint checks if the issue was in the sprint at the time.
+func (tl *issueTimeline) inSprint(t time.Time) bool {
+	if !tl.created.IsZero() && tl.created.After(t) {
+		return false
+	}
+	// The issue was in the sprint since it was created unless it was added later.
+	in := len(tl.sprints) == 0 || containsID(tl.sprints[0].item.From, tl.sprintID)
+	for _, c := range tl.sprints {
+		if c.at.After(t) {
+			break
+		}
+		in = containsID(c.item.To, tl.sprintID)
+	}
+	return in
+}
+
+// joinedBetween checks if the issue was added to the sprint after the start and until the end.
+func (tl *issueTimeline) joinedBetween(start, end time.Time) bool {
+	for _, c := range tl.sprints {
+		if c.at.After(start) && !c.at.After(end) && containsID(c.item.To, tl.sprintID) {
+			return true
+		}
+	}
+	return false
+}
+
+// done checks if the issue was done at the time.
+func (tl *issueTimeline) done(t time.Time) bool {
+	return !tl.doneAt.IsZero() && !tl.doneAt.After(t)
+}
+
+// points returns the story points of the issue at the time.
+func (tl *issueTimeline) points(t time.Time) float64 {
+	for _, c := range tl.estimate {
+		if c.at.After(t) {
+			return parsePoints(c.item.FromString)
+		}
+	}
+	return tl.issue.Points
+}
+
+func (tl *issueTimeline) amount(unit string, t time.Time) float64 {
+	if unit == ReportUnitPoints {
+		return tl.points(t)
+	}
+	return 1
+}
+
+// containsID checks if the comma separated IDs, eg: of the sprints in the changelog, contain the ID.
+func containsID(ids, id string) bool {
+	for s := range strings.SplitSeq(ids, ",") {
+		if strings.TrimSpace(s) == id {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePoints(s string) float64 {
+	v, _ := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return v
+}
+
+func parseReportTime(s string) (time.Time, error) {
+	var err error
+	for _, layout := range []string{jira.RFC3339MilliLayout, jira.RFC3339, time.RFC3339} {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// VelocityReport holds the committed and the completed work of the closed sprints.
+type VelocityReport struct {
+	Unit    string           `json:"unit"`
+	Sprints []VelocitySprint `json:"sprints"`
+	// Average is the average work completed in a sprint.
+	Average float64 `json:"average"`
+}
+
+// VelocitySprint is the committed and the completed work of a sprint.
+type VelocitySprint struct {
+	Sprint    *jira.Sprint `json:"sprint"`
+	Committed float64      `json:"committed"`
+	Completed float64      `json:"completed"`
+}
+
+// NewVelocityReport computes the velocity across the reports of the sprints, in the order given.
+func NewVelocityReport(reports []*SprintReport) *VelocityReport {
+	v := VelocityReport{Unit: ReportUnitIssues, Sprints: make([]VelocitySprint, 0, len(reports))}
+	if len(reports) > 0 {
+		v.Unit = reports[0].Unit
+	}
+
+	var total float64
+	for _, r := range reports {
+		s := VelocitySprint{
+			Sprint:    r.Sprint,
+			Committed: r.Committed.Amount(v.Unit),
+			Completed: r.Completed.Amount(v.Unit),
+		}
+		total += s.Completed
+		v.Sprints = append(v.Sprints, s)
+	}
+	if len(reports) > 0 {
+		v.Average = total / float64(len(reports))
+	}
+	return &v
+}
+
+// SprintReportView renders a sprint report.
+type SprintReportView struct {
+	Report  *SprintReport
+	Display DisplayFormat
+}
+
+// Render renders the report as a chart, or the burndown as a table in the plain and the CSV modes.
+func (v SprintReportView) Render() error {
+	if v.Display.Output.Enabled() {
+		return v.Display.Output.Render(os.Stdout, v.Report)
+	}
+	if v.Display.CSV {
+		return renderCSV(os.Stdout, v.data())
+	}
+	if v.Display.Plain || tui.IsNotTTY() {
+		w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
+		return renderPlain(w, v.data(), "\t")
+	}
+	return v.renderChart(os.Stdout, tui.IsDumbTerminal())
+}
+
+func (v SprintReportView) data() tui.TableData {
+	var data tui.TableData
+	if !v.Display.NoHeaders {
+		data = append(data, []string{"DATE", "REMAINING", "IDEAL"})
+	}
+	for _, p := range v.Report.Burndown {
+		data = append(data, []string{p.Time.Format(time.RFC3339), formatAmount(p.Remaining), formatAmount(p.Ideal)})
+	}
+	return data
+}
+
+func (v SprintReportView) renderChart(w io.Writer, ascii bool) error {
+	r := v.Report
+	s := r.Sprint
+
+	var b strings.Builder
+	b.WriteString(coloredOut(fmt.Sprintf("Sprint #%d ➤ %s", s.ID, s.Name), color.FgWhite, color.Bold))
+	b.WriteString(gray(fmt.Sprintf(" (%s - %s, %s)", formatReportDate(s.StartDate), formatReportDate(s.EndDate), s.Status)))
+	b.WriteString("\n\n")
+
+	rows := []struct {
+		label string
+		total ReportTotal
+		extra string
+	}{
+		{"Committed", r.Committed, ""},
+		{"Completed", r.Completed, percent(r.Completed.Amount(r.Unit), r.Committed.Amount(r.Unit))},
+		{"Added", r.Added, ""},
+		{"Removed", r.Removed, ""},
+	}
+	tw := tabwriter.NewWriter(&b, 0, tabWidth, 2, ' ', 0)
+	for _, row := range rows {
+		line := fmt.Sprintf("%s\t%s", row.label, plural(row.total.Issues, "issue"))
+		if r.Unit == ReportUnitPoints {
+			line += "\t" + formatPoints(row.total.Points, false)
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\n", line, row.extra)
+	}
+	if r.Unit == ReportUnitPoints && r.Reestimated != 0 {
+		_, _ = fmt.Fprintf(tw, "Reestimated\t\t%s\t\n", formatPoints(r.Reestimated, true))
+	}
+	_ = tw.Flush()
+
+	b.WriteString("\n")
+	b.WriteString(coloredOut(fmt.Sprintf("Burndown (%s remaining)", r.Unit), color.FgWhite, color.Bold))
+	b.WriteString("\n")
+
+	bars := make([]chartBar, 0, len(r.Burndown))
+	for _, p := range r.Burndown {
+		bars = append(bars, chartBar{
+			label: p.Time.Format("Jan 02"),
+			value: p.Remaining,
+			ghost: p.Ideal,
+			text:  fmt.Sprintf("%s (ideal %s)", formatAmount(p.Remaining), formatAmount(p.Ideal)),
+		})
+	}
+	b.WriteString(barChart(bars, ascii))
+	b.WriteString("\n")
+	b.WriteString(gray(chartLegend(ascii, "remaining", "ideal")))
+	b.WriteString("\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// VelocityView renders a velocity report.
+type VelocityView struct {
+	Report  *VelocityReport
+	Display DisplayFormat
+}
+
+// Render renders the report as a chart, or as a table in the plain and the CSV modes.
+func (v VelocityView) Render() error {
+	if v.Display.Output.Enabled() {
+		return v.Display.Output.Render(os.Stdout, v.Report)
+	}
+	if v.Display.CSV {
+		return renderCSV(os.Stdout, v.data())
+	}
+	if v.Display.Plain || tui.IsNotTTY() {
+		w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
+		return renderPlain(w, v.data(), "\t")
+	}
+	return v.renderChart(os.Stdout, tui.IsDumbTerminal())
+}
+
+func (v VelocityView) data() tui.TableData {
+	var data tui.TableData
+	if !v.Display.NoHeaders {
+		data = append(data, []string{"ID", "NAME", "COMMITTED", "COMPLETED"})
+	}
+	for _, s := range v.Report.Sprints {
+		data = append(data, []string{
+			strconv.Itoa(s.Sprint.ID), s.Sprint.Name, formatAmount(s.Committed), formatAmount(s.Completed),
+		})
+	}
+	return data
+}
+
+func (v VelocityView) renderChart(w io.Writer, ascii bool) error {
+	r := v.Report
+
+	var b strings.Builder
+	b.WriteString(coloredOut(fmt.Sprintf("Velocity (%s completed)", r.Unit), color.FgWhite, color.Bold))
+	b.WriteString(gray(fmt.Sprintf(" average %s over %s", formatAmount(r.Average), plural(len(r.Sprints), "sprint"))))
+	b.WriteString("\n\n")
+
+	bars := make([]chartBar, 0, len(r.Sprints))
+	for _, s := range r.Sprints {
+		bars = append(bars, chartBar{
+			label: s.Sprint.Name,
+			value: s.Completed,
+			ghost: s.Committed,
+			text: fmt.Sprintf(
+				"%s of %s %s",
+				formatAmount(s.Completed), formatAmount(s.Committed), percent(s.Completed, s.Committed),
+			),
+		})
+	}
+	b.WriteString(barChart(bars, ascii))
+	b.WriteString("\n")
+	b.WriteString(gray(chartLegend(ascii, "completed", "committed")))
+	b.WriteString("\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// chartBar is a bar filled up to the value and shaded up to the ghost value if it is greater,
+// or marked at the ghost value otherwise.
+type chartBar struct {
+	label string
+	value float64
+	ghost float64
+	text  string
+}
+
+// barChart renders the bars horizontally, scaled to the largest value.
+func barChart(bars []chartBar, ascii bool) string {
+	fill, shade, mark := chartChars(ascii)
+
+	var (
+		top   float64
+		label int
+	)
+	for _, bar := range bars {
+		top = math.Max(top, math.Max(bar.value, bar.ghost))
+		label = max(label, len([]rune(bar.label)))
+	}
+	scale := func(v float64) int {
+		if top == 0 || v <= 0 {
+			return 0
+		}
+		return max(1, int(v/top*chartWidth+0.5))
+	}
+
+	var b strings.Builder
+	for _, bar := range bars {
+		filled := scale(bar.value)
+		shaded := max(0, scale(bar.ghost)-filled)
+
+		b.WriteString(bar.label)
+		b.WriteString(strings.Repeat(" ", label-len([]rune(bar.label))+2))
+		if m := scale(bar.ghost); m > 0 && m < filled {
+			b.WriteString(strings.Repeat(fill, m-1) + mark + strings.Repeat(fill, filled-m))
+		} else {
+			b.WriteString(strings.Repeat(fill, filled))
+		}
+		b.WriteString(strings.Repeat(shade, shaded))
+		b.WriteString(strings.Repeat(" ", chartWidth-filled-shaded+1))
+		b.WriteString(bar.text)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func chartChars(ascii bool) (fill, shade, mark string) {
+	if ascii {
+		return "#", ".", "|"
+	}
+	return "█", "░", "▐"
+}
+
+func chartLegend(ascii bool, filled, ghost string) string {
+	fill, shade, mark := chartChars(ascii)
+	return fmt.Sprintf("%s %s  %s %s %s", fill, filled, shade, mark, ghost)
+}
+
+func formatAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatReportDate(s string) string {
+	t, err := parseReportTime(s)
+	if err != nil {
+		return "-"
+	}
+	return t.Format(reportDateLayout)
+}
+
+// formatPoints formats the story points, with the sign if it is a change.
+func formatPoints(v float64, change bool) string {
+	s := formatAmount(v)
+	if change && v > 0 {
+		s = "+" + s
+	}
+	if v == 1 {
+		return s + " point"
+	}
+	return s + " points"
+}
+
+func percent(v, total float64) string {
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("(%.0f%%)", v/total*100)
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
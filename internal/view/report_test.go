@@ -0,0 +1,196 @@
+package view
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func reportIssues() []*jira.IssueHistory {
+	issue := func(key, status, category, created string, points float64, changes ...jira.ChangelogHistory) *jira.IssueHistory {
+		iss := &jira.IssueHistory{Key: key, Points: points}
+		iss.Fields.Summary = key + " summary"
+		iss.Fields.Status.Name = status
+		iss.Fields.Status.StatusCategory.Key = category
+		iss.Fields.Created = created
+		iss.Changelog.Histories = changes
+		return iss
+	}
+	change := func(at string, item jira.ChangelogItem) jira.ChangelogHistory {
+		return jira.ChangelogHistory{Created: at, Items: []jira.ChangelogItem{item}}
+	}
+
+	return []*jira.IssueHistory{
+		// Committed and completed.
+		issue("TEST-1", "Done", "done", "2020-11-10T09:00:00.000+0000", 5,
+			change("2020-11-12T00:00:00.000+0000", jira.ChangelogItem{Field: "Sprint", To: "1", ToString: "Sprint 1"}),
+			change("2020-11-16T12:00:00.000+0000", jira.ChangelogItem{Field: "status", FromString: "In Progress", ToString: "Done"}),
+		),
+		// Committed, in the sprint since it was created, and reestimated.
+		issue("TEST-2", "In Progress", "indeterminate", "2020-11-10T09:00:00.000+0000", 3,
+			change("2020-11-16T00:00:00.000+0000", jira.ChangelogItem{Field: "Story Points", FieldID: "customfield_10016", FromString: "2", ToString: "3"}),
+		),
+		// Created in the sprint after it started.
+		issue("TEST-3", "To Do", "new", "2020-11-16T06:00:00.000+0000", 1),
+		// Committed and moved to another sprint.
+		issue("TEST-4", "To Do", "new", "2020-11-10T09:00:00.000+0000", 2,
+			change("2020-11-12T00:00:00.000+0000", jira.ChangelogItem{Field: "Sprint", To: "1", ToString: "Sprint 1"}),
+			change("2020-11-17T00:00:00.000+0000", jira.ChangelogItem{Field: "Sprint", From: "1", FromString: "Sprint 1", To: "2", ToString: "Sprint 2"}),
+		),
+	}
+}
+
+func TestNewSprintReport(t *testing.T) {
+	t.Parallel()
+
+	sprint := &jira.Sprint{
+		ID:           1,
+		Name:         "Sprint 1",
+		Status:       jira.SprintStateClosed,
+		StartDate:    "2020-11-15T00:00:00.000Z",
+		EndDate:      "2020-11-18T00:00:00.000Z",
+		CompleteDate: "2020-11-18T00:00:00.000Z",
+	}
+	now := time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2020, 11, d, 0, 0, 0, 0, time.UTC) }
+
+	cases := []struct {
+		name     string
+		points   PointsField
+		unit     string
+		totals   [4]ReportTotal // Committed, completed, added and removed.
+		reest    float64
+		burndown []BurndownPoint
+	}{
+		{
+			name:   "story points",
+			points: PointsField{ID: "customfield_10016", Name: DefaultPointsName},
+			unit:   ReportUnitPoints,
+			totals: [4]ReportTotal{{Issues: 3, Points: 9}, {Issues: 1, Points: 5}, {Issues: 1, Points: 1}, {Issues: 1, Points: 2}},
+			reest:  1,
+			burndown: []BurndownPoint{
+				{Time: day(15), Remaining: 9, Ideal: 9},
+				{Time: day(16), Remaining: 10, Ideal: 6},
+				{Time: day(17), Remaining: 4, Ideal: 3},
+				{Time: day(18), Remaining: 4, Ideal: 0},
+			},
+		},
+		{
+			name:   "issues",
+			unit:   ReportUnitIssues,
+			totals: [4]ReportTotal{{Issues: 3, Points: 10}, {Issues: 1, Points: 5}, {Issues: 1, Points: 1}, {Issues: 1, Points: 2}},
+			burndown: []BurndownPoint{
+				{Time: day(15), Remaining: 3, Ideal: 3},
+				{Time: day(16), Remaining: 3, Ideal: 2},
+				{Time: day(17), Remaining: 2, Ideal: 1},
+				{Time: day(18), Remaining: 2, Ideal: 0},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := NewSprintReport(sprint, reportIssues(), tc.points, now)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tc.unit, r.Unit)
+			assert.Equal(t, tc.totals, [4]ReportTotal{r.Committed, r.Completed, r.Added, r.Removed})
+			assert.Equal(t, tc.reest, r.Reestimated)
+			assert.Equal(t, tc.burndown, r.Burndown)
+
+			assert.Equal(t, []ReportIssue{
+				{Key: "TEST-1", Summary: "TEST-1 summary", Status: "Done", Points: 5, Committed: true, Completed: true},
+				{Key: "TEST-2", Summary: "TEST-2 summary", Status: "In Progress", Points: 3, Committed: true},
+				{Key: "TEST-3", Summary: "TEST-3 summary", Status: "To Do", Points: 1, Added: true},
+				{Key: "TEST-4", Summary: "TEST-4 summary", Status: "To Do", Points: 2, Committed: true, Removed: true},
+			}, r.Issues)
+		})
+	}
+}
+
+func TestNewSprintReportActiveSprint(t *testing.T) {
+	t.Parallel()
+
+	sprint := &jira.Sprint{
+		ID:        1,
+		Name:      "Sprint 1",
+		Status:    jira.SprintStateActive,
+		StartDate: "2020-11-15T00:00:00.000Z",
+		EndDate:   "2020-11-18T00:00:00.000Z",
+	}
+	now := time.Date(2020, 11, 16, 8, 0, 0, 0, time.UTC)
+
+	r, err := NewSprintReport(sprint, reportIssues(), PointsField{}, now)
+	assert.NoError(t, err)
+
+	// The report is as of now, so TEST-1 is not completed yet and TEST-4 is still in the sprint.
+	assert.Equal(t, ReportTotal{Issues: 3, Points: 10}, r.Committed)
+	assert.Equal(t, ReportTotal{}, r.Completed)
+	assert.Equal(t, ReportTotal{Issues: 1, Points: 1}, r.Added)
+	assert.Equal(t, ReportTotal{}, r.Removed)
+	assert.Len(t, r.Burndown, 3)
+	assert.Equal(t, now, r.Burndown[2].Time)
+	assert.Equal(t, 4.0, r.Burndown[2].Remaining)
+
+	_, err = NewSprintReport(&jira.Sprint{ID: 2, Name: "Sprint 2", Status: jira.SprintStateFuture}, nil, PointsField{}, now)
+	assert.EqualError(t, err, `sprint "Sprint 2" has not started yet`)
+}
+
+func TestNewVelocityReport(t *testing.T) {
+	t.Parallel()
+
+	s1, s2 := &jira.Sprint{ID: 1, Name: "Sprint 1"}, &jira.Sprint{ID: 2, Name: "Sprint 2"}
+
+	v := NewVelocityReport([]*SprintReport{
+		{Sprint: s1, Unit: ReportUnitPoints, Committed: ReportTotal{Issues: 4, Points: 20}, Completed: ReportTotal{Issues: 3, Points: 15}},
+		{Sprint: s2, Unit: ReportUnitPoints, Committed: ReportTotal{Issues: 5, Points: 20}, Completed: ReportTotal{Issues: 5, Points: 20}},
+	})
+
+	assert.Equal(t, &VelocityReport{
+		Unit: ReportUnitPoints,
+		Sprints: []VelocitySprint{
+			{Sprint: s1, Committed: 20, Completed: 15},
+			{Sprint: s2, Committed: 20, Completed: 20},
+		},
+		Average: 17.5,
+	}, v)
+
+	assert.Equal(t, &VelocityReport{Unit: ReportUnitIssues, Sprints: []VelocitySprint{}}, NewVelocityReport(nil))
+}
+
+func TestBarChart(t *testing.T) {
+	t.Parallel()
+
+	bars := []chartBar{
+		{label: "Sprint 1", value: 15, ghost: 20, text: "15 of 20"},
+		{label: "S2", value: 20, ghost: 10, text: "20 of 10"},
+		{label: "S3", value: 0, ghost: 0, text: "0"},
+	}
+
+	expected := strings.Join([]string{
+		"Sprint 1  " + strings.Repeat("#", 38) + strings.Repeat(".", 12) + " 15 of 20",
+		"S2        " + strings.Repeat("#", 24) + "|" + strings.Repeat("#", 25) + " 20 of 10",
+		"S3        " + strings.Repeat(" ", 50) + " 0",
+		"",
+	}, "\n")
+	assert.Equal(t, expected, barChart(bars, true))
+
+	assert.Contains(t, barChart(bars[:1], false), strings.Repeat("█", 38)+strings.Repeat("░", 12))
+}
+
+func TestContainsID(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, containsID("1", "1"))
+	assert.True(t, containsID("12, 1", "1"))
+	assert.False(t, containsID("12, 13", "1"))
+	assert.False(t, containsID("", "1"))
+}
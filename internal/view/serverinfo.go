@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 	"text/tabwriter"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -18,6 +19,7 @@ type ServerInfo struct {
 	data   *jira.ServerInfo
 	writer io.Writer
 	buf    *bytes.Buffer
+	output Output
 }
 
 // NewServerInfo initializes server info struct.
@@ -41,8 +43,19 @@ func WithServerInfoWriter(w io.Writer) ServerInfoOption {
 	}
 }
 
+// WithServerInfoOutput sets a machine readable output for the serverinfo view.
+func WithServerInfoOutput(o Output) ServerInfoOption {
+	return func(s *ServerInfo) {
+		s.output = o
+	}
+}
+
 // Render renders the serverinfo view.
 func (s ServerInfo) Render() error {
+	if s.output.Enabled() {
+		return s.output.Render(os.Stdout, s.data)
+	}
+
 	_, _ = fmt.Fprintf(s.writer, `SERVER INFO
 -----------
 
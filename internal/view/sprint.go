@@ -16,8 +16,8 @@ import (
 	"github.com/rivo/tview"
 )
 
-// SprintIssueFunc provides issues in the sprint.
-type SprintIssueFunc func(boardID, sprintID int) []*jira.Issue
+// SprintIssueFunc provides a pager for the issues in the sprint.
+type SprintIssueFunc func(boardID, sprintID int) IssuePager
 
 // SprintList is a list view for sprints.
 type SprintList struct {
@@ -33,6 +33,10 @@ type SprintList struct {
 //
 //nolint:dupl
 func (sl *SprintList) Render() error {
+	if sl.Display.Output.Enabled() {
+		return sl.Display.Output.Render(os.Stdout, sl.Data)
+	}
+
 	renderer, err := MDRenderer()
 	if err != nil {
 		return err
@@ -46,7 +50,7 @@ func (sl *SprintList) Render() error {
 				len(sl.Data), sl.Board, sl.Project,
 			),
 		),
-		tui.WithInitialText(helpText),
+		tui.WithInitialText(previewHelpText(sl.Display.TableStyle.Keymap)),
 		tui.WithContentTableOpts(
 			tui.WithFixedColumns(sl.Display.FixedColumns),
 			tui.WithTableStyle(sl.Display.TableStyle),
@@ -78,6 +82,10 @@ func (sl *SprintList) Render() error {
 
 // RenderInTable renders the list in table view.
 func (sl *SprintList) RenderInTable() error {
+	if sl.Display.Output.Enabled() {
+		return sl.Display.Output.Render(os.Stdout, sl.Data)
+	}
+
 	if sl.Display.Plain || tui.IsDumbTerminal() || tui.IsNotTTY() {
 		w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
 		return sl.renderPlain(w)
@@ -87,6 +95,7 @@ func (sl *SprintList) RenderInTable() error {
 	view := tui.NewTable(
 		tui.WithFixedColumns(sl.Display.FixedColumns),
 		tui.WithTableStyle(sl.Display.TableStyle),
+		tui.WithTableLayout(sl.Display.Layout, sl.Display.SaveLayout),
 		tui.WithTableFooterText(
 			fmt.Sprintf(
 				"Showing %d results from board %q of project %q",
@@ -111,7 +120,7 @@ func (sl *SprintList) data() []tui.PreviewData {
 		Key:  "help",
 		Menu: "?",
 		Contents: func(s string) interface{} {
-			return helpText
+			return previewHelpText(sl.Display.TableStyle.Keymap)
 		},
 	})
 	for _, s := range sl.Data {
@@ -127,8 +136,7 @@ func (sl *SprintList) data() []tui.PreviewData {
 				cmdutil.FormatDateTimeHuman(s.EndDate, time.RFC3339),
 			)),
 			Contents: func(key string) interface{} {
-				issues := sl.Issues(bid, sid)
-				return sl.tabularize(issues)
+				return pagedContents(sl.Issues(bid, sid), sl.tabularize)
 			},
 		})
 	}
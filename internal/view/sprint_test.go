@@ -78,11 +78,11 @@ func TestSprintPreviewLayoutData(t *testing.T) {
 		Board:   "Test Board",
 		Server:  "https://test.local",
 		Data:    []*jira.Sprint{&sprint1, &sprint2},
-		Issues: func(boardID, sprintID int) []*jira.Issue {
+		Issues: func(boardID, sprintID int) IssuePager {
 			if sprintID == 1 {
-				return []*jira.Issue{&issue1}
+				return &singlePage{&issue1}
 			}
-			return []*jira.Issue{&issue2, &issue1}
+			return &singlePage{&issue2, &issue1}
 		},
 	}
 
@@ -94,7 +94,7 @@ func TestSprintPreviewLayoutData(t *testing.T) {
 		{
 			Key:      "help",
 			Menu:     "?",
-			Contents: helpText,
+			Contents: previewHelpText(tui.DefaultKeymap()),
 		},
 		{
 			Key:  "1-1-2020-12-07T16:12:00.000Z",
@@ -0,0 +1,110 @@
+package view
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Issue events found when watching a query.
+const (
+	IssueAdded   = "added"
+	IssueChanged = "changed"
+	IssueRemoved = "removed"
+)
+
+// Watch sets how a list is watched for changes.
+type Watch struct {
+	Interval time.Duration
+	// Notify is the notification emitted on changes, if any, see tui.Notifications.
+	Notify string
+	// Pager returns a pager that fetches the issues again.
+	Pager func() IssuePager
+}
+
+// IssueEvent is a change of the issues matching a query since the previous poll.
+type IssueEvent struct {
+	Time     time.Time     `json:"time"`
+	Event    string        `json:"event"`
+	Key      string        `json:"key"`
+	Summary  string        `json:"summary"`
+	Status   string        `json:"status"`
+	Assignee string        `json:"assignee"`
+	Updated  string        `json:"updated"`
+	Changes  []FieldChange `json:"changes,omitempty"`
+}
+
+// FieldChange is a change of a field of an issue.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// IssueEvents compares the issues matching a query in two polls. The issues that are new
+// in the query, that were updated or that no longer match the query are returned in order.
+func IssueEvents(prev, next []*jira.Issue, now time.Time) []IssueEvent {
+	var (
+		events []IssueEvent
+		seen   = make(map[string]bool, len(next))
+		old    = make(map[string]*jira.Issue, len(prev))
+	)
+
+	for _, iss := range prev {
+		old[iss.Key] = iss
+	}
+
+	for _, iss := range next {
+		seen[iss.Key] = true
+
+		o, ok := old[iss.Key]
+		switch {
+		case !ok:
+			events = append(events, issueEvent(now, IssueAdded, iss, nil))
+		case o.Fields.Updated != iss.Fields.Updated:
+			events = append(events, issueEvent(now, IssueChanged, iss, fieldChanges(o, iss)))
+		}
+	}
+	for _, iss := range prev {
+		if !seen[iss.Key] {
+			events = append(events, issueEvent(now, IssueRemoved, iss, nil))
+		}
+	}
+	return events
+}
+
+func issueEvent(now time.Time, event string, iss *jira.Issue, changes []FieldChange) IssueEvent {
+	return IssueEvent{
+		Time:     now,
+		Event:    event,
+		Key:      iss.Key,
+		Summary:  iss.Fields.Summary,
+		Status:   iss.Fields.Status.Name,
+		Assignee: iss.Fields.Assignee.Name,
+		Updated:  iss.Fields.Updated,
+		Changes:  changes,
+	}
+}
+
+// fieldChanges returns the changes of the fields shown in the lists, eg: a comment changes none of them.
+func fieldChanges(prev, next *jira.Issue) []FieldChange {
+	fields := []struct {
+		name       string
+		prev, next string
+	}{
+		{fieldSummary, prev.Fields.Summary, next.Fields.Summary},
+		{fieldStatus, prev.Fields.Status.Name, next.Fields.Status.Name},
+		{fieldAssignee, prev.Fields.Assignee.Name, next.Fields.Assignee.Name},
+		{fieldPriority, prev.Fields.Priority.Name, next.Fields.Priority.Name},
+		{fieldResolution, prev.Fields.Resolution.Name, next.Fields.Resolution.Name},
+	}
+
+	var changes []FieldChange
+	for _, f := range fields {
+		if f.prev != f.next {
+			changes = append(changes, FieldChange{Field: strings.ToLower(f.name), From: f.prev, To: f.next})
+		}
+	}
+	return changes
+}
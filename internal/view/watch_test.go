@@ -0,0 +1,61 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestIssueEvents(t *testing.T) {
+	t.Parallel()
+
+	issue := func(key, status, assignee, updated string) *jira.Issue {
+		iss := &jira.Issue{Key: key}
+		iss.Fields.Summary = key + " summary"
+		iss.Fields.Status.Name = status
+		iss.Fields.Assignee.Name = assignee
+		iss.Fields.Updated = updated
+		return iss
+	}
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	prev := []*jira.Issue{
+		issue("TEST-1", "To Do", "", "2024-01-01T09:00:00.000+0000"),
+		issue("TEST-2", "To Do", "Ana", "2024-01-01T09:00:00.000+0000"),
+		issue("TEST-3", "In Progress", "Ana", "2024-01-01T09:00:00.000+0000"),
+	}
+	next := []*jira.Issue{
+		issue("TEST-4", "To Do", "", "2024-01-01T09:55:00.000+0000"),
+		issue("TEST-1", "In Progress", "Bob", "2024-01-01T09:50:00.000+0000"),
+		issue("TEST-2", "To Do", "Ana", "2024-01-01T09:45:00.000+0000"),
+	}
+
+	expected := []IssueEvent{
+		{
+			Time: now, Event: IssueAdded, Key: "TEST-4", Summary: "TEST-4 summary",
+			Status: "To Do", Updated: "2024-01-01T09:55:00.000+0000",
+		},
+		{
+			Time: now, Event: IssueChanged, Key: "TEST-1", Summary: "TEST-1 summary",
+			Status: "In Progress", Assignee: "Bob", Updated: "2024-01-01T09:50:00.000+0000",
+			Changes: []FieldChange{
+				{Field: "status", From: "To Do", To: "In Progress"},
+				{Field: "assignee", From: "", To: "Bob"},
+			},
+		},
+		{
+			Time: now, Event: IssueChanged, Key: "TEST-2", Summary: "TEST-2 summary",
+			Status: "To Do", Assignee: "Ana", Updated: "2024-01-01T09:45:00.000+0000",
+		},
+		{
+			Time: now, Event: IssueRemoved, Key: "TEST-3", Summary: "TEST-3 summary",
+			Status: "In Progress", Assignee: "Ana", Updated: "2024-01-01T09:00:00.000+0000",
+		},
+	}
+
+	assert.Equal(t, expected, IssueEvents(prev, next, now))
+	assert.Empty(t, IssueEvents(prev, prev, now))
+}
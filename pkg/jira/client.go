@@ -13,6 +13,7 @@ import (
 	"net/http/httputil"
 	"os"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -121,11 +122,21 @@ type Client struct {
 	server    string
 	login     string
 	authType  *AuthType
+	mu        sync.Mutex // Guards token.
 	token     string
 	timeout   time.Duration
 	debug     bool
+	source    TokenSource
+	refresh   TokenRefresher
 }
 
+// TokenSource returns a valid token before each request, eg: an OAuth 2.0
+// access token that is refreshed when it expires.
+type TokenSource func() (string, error)
+
+// TokenRefresher returns a fresh token when the server rejects the current one.
+type TokenRefresher func() (string, error)
+
 // ClientFunc decorates option for client.
 type ClientFunc func(*Client)
 
@@ -143,6 +154,12 @@ func NewClient(c Config, opts ...ClientFunc) *Client {
 		opt(&client)
 	}
 
+	// Set default auth type to `basic`.
+	if client.authType == nil {
+		basic := AuthTypeBasic
+		client.authType = &basic
+	}
+
 	transport := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		TLSClientConfig: &tls.Config{
@@ -194,6 +211,21 @@ func WithInsecureTLS(ins bool) ClientFunc {
 	}
 }
 
+// WithTokenSource is a functional opt to get the token from the source before each request.
+func WithTokenSource(fn TokenSource) ClientFunc {
+	return func(c *Client) {
+		c.source = fn
+	}
+}
+
+// WithTokenRefresher is a functional opt to refresh the token and retry
+// the request once if the server responds with 401 Unauthorized.
+func WithTokenRefresher(fn TokenRefresher) ClientFunc {
+	return func(c *Client) {
+		c.refresh = fn
+	}
+}
+
 // Get sends GET request to v3 version of the jira api.
 func (c *Client) Get(ctx context.Context, path string, headers Header) (*http.Response, error) {
 	return c.request(ctx, http.MethodGet, c.server+baseURLv3+path, nil, headers)
@@ -245,6 +277,66 @@ func (c *Client) DeleteV2(ctx context.Context, path string, headers Header) (*ht
 }
 
 func (c *Client) request(ctx context.Context, method, endpoint string, body []byte, headers Header) (*http.Response, error) {
+	token, err := c.getToken()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.send(ctx, method, endpoint, body, headers, token)
+	if err != nil || res.StatusCode != http.StatusUnauthorized || c.refresh == nil {
+		return res, err
+	}
+
+	token, ok := c.refreshToken(token)
+	if !ok {
+		return res, nil
+	}
+	_ = res.Body.Close()
+
+	return c.send(ctx, method, endpoint, body, headers, token)
+}
+
+// getToken returns the token to authenticate the request with.
+func (c *Client) getToken() (string, error) {
+	if c.source != nil {
+		token, err := c.source()
+		if err != nil {
+			return "", err
+		}
+
+		c.mu.Lock()
+		c.token = token
+		c.mu.Unlock()
+
+		return token, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.token, nil
+}
+
+// refreshToken replaces the token rejected by the server. The token is refreshed
+// only once when concurrent requests are rejected with the same token.
+func (c *Client) refreshToken(rejected string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != rejected {
+		return c.token, true
+	}
+
+	token, err := c.refresh()
+	if err != nil || token == "" || token == rejected {
+		return "", false
+	}
+	c.token = token
+
+	return token, true
+}
+
+func (c *Client) send(ctx context.Context, method, endpoint string, body []byte, headers Header, token string) (*http.Response, error) {
 	var (
 		req *http.Request
 		res *http.Response
@@ -266,23 +358,17 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body []by
 		req.Header.Set(k, v)
 	}
 
-	// Set default auth type to `basic`.
-	if c.authType == nil {
-		basic := AuthTypeBasic
-		c.authType = &basic
-	}
-
 	// When need to compare using `String()` here, it is used to handle cases where the
 	// authentication type might be empty, ensuring it defaults to the appropriate value.
 	switch c.authType.String() {
 	case string(AuthTypeMTLS):
-		if c.token != "" {
-			req.Header.Add("Authorization", "Bearer "+c.token)
+		if token != "" {
+			req.Header.Add("Authorization", "Bearer "+token)
 		}
-	case string(AuthTypeBearer):
-		req.Header.Add("Authorization", "Bearer "+c.token)
+	case string(AuthTypeBearer), string(AuthTypeOAuth2):
+		req.Header.Add("Authorization", "Bearer "+token)
 	case string(AuthTypeBasic):
-		req.SetBasicAuth(c.login, c.token)
+		req.SetBasicAuth(c.login, token)
 	}
 
 	httpClient := &http.Client{Transport: c.transport}
@@ -3,9 +3,12 @@ package jira
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -207,3 +210,116 @@ func TestDeleteV2(t *testing.T) {
 
 	_ = resp.Body.Close()
 }
+
+func TestTokenRefresher(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		_, token, _ := r.BasicAuth()
+		if token != "fresh" {
+			w.WriteHeader(401)
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	var refreshed int
+
+	client := NewClient(
+		Config{Server: server.URL, Login: "user", APIToken: "stale"},
+		WithTimeout(3*time.Second),
+		WithTokenRefresher(func() (string, error) {
+			refreshed++
+			return "fresh", nil
+		}),
+	)
+
+	resp, err := client.GetV2(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, refreshed)
+	_ = resp.Body.Close()
+
+	// Subsequent requests use the refreshed token.
+	resp, err = client.GetV2(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, 1, refreshed)
+	_ = resp.Body.Close()
+}
+
+func TestTokenRefresherConcurrent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, token, _ := r.BasicAuth()
+		if token != "fresh" {
+			w.WriteHeader(401)
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	var refreshed atomic.Int32
+
+	client := NewClient(
+		Config{Server: server.URL, Login: "user", APIToken: "stale"},
+		WithTimeout(3*time.Second),
+		WithTokenRefresher(func() (string, error) {
+			refreshed.Add(1)
+			return "fresh", nil
+		}),
+	)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			resp, err := client.GetV2(context.Background(), "/myself", nil)
+			assert.NoError(t, err)
+			assert.Equal(t, 200, resp.StatusCode)
+			_ = resp.Body.Close()
+		})
+	}
+	wg.Wait()
+
+	// The requests rejected with the same token refresh it only once.
+	assert.Equal(t, int32(1), refreshed.Load())
+}
+
+func TestTokenSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ex/jira/cloud-1/rest/api/2/myself", r.URL.Path)
+		assert.Equal(t, "Bearer access-1", r.Header.Get("Authorization"))
+
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	authType := AuthTypeOAuth2
+	client := NewClient(
+		Config{Server: server.URL + "/ex/jira/cloud-1", AuthType: &authType},
+		WithTimeout(3*time.Second),
+		WithTokenSource(func() (string, error) {
+			return "access-1", nil
+		}),
+	)
+
+	resp, err := client.GetV2(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	_ = resp.Body.Close()
+
+	client = NewClient(
+		Config{Server: server.URL, AuthType: &authType},
+		WithTokenSource(func() (string, error) {
+			return "", fmt.Errorf("not logged in")
+		}),
+	)
+
+	_, err = client.GetV2(context.Background(), "/myself", nil)
+	assert.EqualError(t, err, "not logged in")
+}
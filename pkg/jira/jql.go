@@ -0,0 +1,195 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+var boldTags = regexp.MustCompile(`</?b>`)
+
+// JQLAutocompleteData holds response from GET /jql/autocompletedata endpoint.
+type JQLAutocompleteData struct {
+	Fields        []JQLField    `json:"visibleFieldNames"`
+	Functions     []JQLFunction `json:"visibleFunctionNames"`
+	ReservedWords []string      `json:"jqlReservedWords"`
+}
+
+// JQLField is a field that can be used in the JQL.
+type JQLField struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	Orderable   string   `json:"orderable"`
+	Searchable  string   `json:"searchable"`
+	Auto        string   `json:"auto"`
+	CFID        string   `json:"cfid,omitempty"`
+	Operators   []string `json:"operators"`
+	Types       []string `json:"types"`
+}
+
+// IsOrderable checks if the field can be used in the ORDER BY clause.
+func (f JQLField) IsOrderable() bool {
+	return f.Orderable == "true"
+}
+
+// IsSearchable checks if the field can be used in a clause.
+func (f JQLField) IsSearchable() bool {
+	return f.Searchable == "true"
+}
+
+// HasSuggestions checks if the server can suggest values for the field.
+func (f JQLField) HasSuggestions() bool {
+	return f.Auto == "true"
+}
+
+// JQLFunction is a function that can be used in the JQL.
+type JQLFunction struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	IsList      string   `json:"isList"`
+	Types       []string `json:"types"`
+}
+
+// JQLSuggestion is a suggested value for a field.
+type JQLSuggestion struct {
+	Value       string `json:"value"`
+	DisplayName string `json:"displayName"`
+}
+
+// JQLParseResult is a result of parsing a JQL query.
+type JQLParseResult struct {
+	Query  string   `json:"query"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// IsValid checks if the query is valid.
+func (r *JQLParseResult) IsValid() bool {
+	return len(r.Errors) == 0
+}
+
+// JQLAutocompleteData fetches fields, functions and reserved words using GET /jql/autocompletedata endpoint.
+func (c *Client) JQLAutocompleteData() (*JQLAutocompleteData, error) {
+	res, err := c.GetV2(context.Background(), "/jql/autocompletedata", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out JQLAutocompleteData
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// JQLSuggestions fetches value suggestions for a field using GET /jql/autocompletedata/suggestions endpoint.
+func (c *Client) JQLSuggestions(field, value string) ([]JQLSuggestion, error) {
+	path := fmt.Sprintf(
+		"/jql/autocompletedata/suggestions?fieldName=%s&fieldValue=%s",
+		url.QueryEscape(field), url.QueryEscape(value),
+	)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Results []JQLSuggestion `json:"results"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	// The display name highlights the matching part with bold tags.
+	for i, r := range out.Results {
+		out.Results[i].DisplayName = boldTags.ReplaceAllString(r.DisplayName, "")
+	}
+	return out.Results, nil
+}
+
+// JQLParse parses and validates the queries using POST /jql/parse endpoint.
+// It is only available in the v3 version of the API.
+func (c *Client) JQLParse(queries ...string) ([]*JQLParseResult, error) {
+	body, err := json.Marshal(struct {
+		Queries []string `json:"queries"`
+	}{Queries: queries})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Post(context.Background(), "/jql/parse?validation=strict", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Queries []*JQLParseResult `json:"queries"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out.Queries, nil
+}
+
+// JQLValidateV2 validates the query using v2 version of the GET /search endpoint,
+// since the /jql/parse endpoint is not available in the v2 version of the API.
+func (c *Client) JQLValidateV2(jql string) (*JQLParseResult, error) {
+	path := fmt.Sprintf("/search?jql=%s&maxResults=0&validateQuery=strict", url.QueryEscape(jql))
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	out := JQLParseResult{Query: jql}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return &out, nil
+	case http.StatusBadRequest:
+		e := formatUnexpectedResponse(res)
+		out.Errors = append(out.Errors, e.Body.ErrorMessages...)
+		for _, v := range e.Body.Errors {
+			out.Errors = append(out.Errors, v)
+		}
+		if len(out.Errors) == 0 {
+			out.Errors = []string{"invalid query"}
+		}
+		return &out, nil
+	}
+	return nil, formatUnexpectedResponse(res)
+}
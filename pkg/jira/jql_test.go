@@ -0,0 +1,146 @@
+package jira
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJQLAutocompleteData(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/jql/autocompletedata", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/jql-autocomplete.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.JQLAutocompleteData()
+	assert.NoError(t, err)
+
+	assert.Len(t, actual.Fields, 2)
+	assert.Equal(t, "assignee", actual.Fields[0].Value)
+	assert.True(t, actual.Fields[0].IsOrderable())
+	assert.True(t, actual.Fields[0].IsSearchable())
+	assert.True(t, actual.Fields[0].HasSuggestions())
+	assert.False(t, actual.Fields[1].HasSuggestions())
+	assert.Equal(t, "cf[10016]", actual.Fields[1].CFID)
+	assert.Equal(t, []JQLFunction{
+		{Value: "currentUser()", DisplayName: "currentUser()", Types: []string{"com.atlassian.jira.user.ApplicationUser"}},
+		{Value: `membersOf("")`, DisplayName: `membersOf("")`, IsList: "true", Types: []string{"com.atlassian.jira.user.ApplicationUser"}},
+	}, actual.Functions)
+	assert.Equal(t, []string{"and", "or", "not", "empty", "null", "order", "by"}, actual.ReservedWords)
+
+	unexpectedStatusCode = true
+
+	_, err = client.JQLAutocompleteData()
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestJQLSuggestions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/jql/autocompletedata/suggestions", r.URL.Path)
+		assert.Equal(t, "status", r.URL.Query().Get("fieldName"))
+		assert.Equal(t, "in pro", r.URL.Query().Get("fieldValue"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"results":[{"value":"\"In Progress\"","displayName":"<b>In</b> <b>Pro</b>gress"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.JQLSuggestions("status", "in pro")
+	assert.NoError(t, err)
+	assert.Equal(t, []JQLSuggestion{{Value: `"In Progress"`, DisplayName: "In Progress"}}, actual)
+}
+
+func TestJQLParse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/jql/parse", r.URL.Path)
+		assert.Equal(t, "strict", r.URL.Query().Get("validation"))
+		assert.Equal(t, "POST", r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var req struct {
+			Queries []string `json:"queries"`
+		}
+		assert.NoError(t, json.Unmarshal(body, &req))
+		assert.Equal(t, []string{"project = TEST", "project = TEST AND foo"}, req.Queries)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"queries":[
+			{"query":"project = TEST","structure":{}},
+			{"query":"project = TEST AND foo","errors":["Error in the JQL Query: Expecting operator before the end of the query. (line 1, character 23)"]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.JQLParse("project = TEST", "project = TEST AND foo")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.True(t, actual[0].IsValid())
+	assert.False(t, actual[1].IsValid())
+	assert.Equal(t, []string{
+		"Error in the JQL Query: Expecting operator before the end of the query. (line 1, character 23)",
+	}, actual[1].Errors)
+}
+
+func TestJQLValidateV2(t *testing.T) {
+	var invalid bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+		assert.Equal(t, "0", r.URL.Query().Get("maxResults"))
+		assert.Equal(t, "strict", r.URL.Query().Get("validateQuery"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if invalid {
+			w.WriteHeader(400)
+			_, _ = w.Write([]byte(`{"errorMessages":["Field 'foo' does not exist or you do not have permission to view it."],"errors":{}}`))
+		} else {
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":0,"total":10,"issues":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.JQLValidateV2("project = TEST")
+	assert.NoError(t, err)
+	assert.True(t, actual.IsValid())
+
+	invalid = true
+
+	actual, err = client.JQLValidateV2("foo = bar")
+	assert.NoError(t, err)
+	assert.Equal(t, &JQLParseResult{
+		Query:  "foo = bar",
+		Errors: []string{"Field 'foo' does not exist or you do not have permission to view it."},
+	}, actual)
+}
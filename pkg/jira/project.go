@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/url"
 )
 
 const (
@@ -34,3 +35,25 @@ func (c *Client) Project() ([]*Project, error) {
 
 	return out, err
 }
+
+// GetProject fetches response from /project/{key} endpoint.
+func (c *Client) GetProject(key string) (*Project, error) {
+	res, err := c.GetV2(context.Background(), "/project/"+url.PathEscape(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Project
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
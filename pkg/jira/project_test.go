@@ -71,3 +71,31 @@ func TestProjects(t *testing.T) {
 	_, err = client.Project()
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestGetProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/project/PRJ1":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"key": "PRJ1", "name": "Project 1", "style": "classic"}`))
+		default:
+			w.WriteHeader(404)
+			_, _ = w.Write([]byte(`{"errorMessages": ["No project could be found with key 'NONE'."]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetProject("PRJ1")
+	assert.NoError(t, err)
+	assert.Equal(t, "PRJ1", actual.Key)
+	assert.Equal(t, "Project 1", actual.Name)
+	assert.Equal(t, ProjectTypeClassic, actual.Type)
+
+	_, err = client.GetProject("NONE")
+	var e *ErrUnexpectedResponse
+	assert.ErrorAs(t, err, &e)
+	assert.Equal(t, 404, e.StatusCode)
+}
@@ -12,15 +12,59 @@ import (
 type SearchResult struct {
 	IsLast        bool     `json:"isLast"`
 	NextPageToken string   `json:"nextPageToken"`
+	Total         int      `json:"total"` // Only returned by the v2 and the agile endpoints.
 	Issues        []*Issue `json:"issues"`
 }
 
 // Search searches for issues using v3 version of the Jira GET /search endpoint.
 func (c *Client) Search(jql string, limit uint) (*SearchResult, error) {
+	return c.SearchPage(jql, "", limit)
+}
+
+// SearchPage searches for the page of issues after the given token, the
+// next page token of the previous page, using v3 version of the Jira GET /search endpoint.
+func (c *Client) SearchPage(jql, pageToken string, limit uint) (*SearchResult, error) {
 	path := fmt.Sprintf("/search/jql?jql=%s&maxResults=%d&fields=*all", url.QueryEscape(jql), limit)
+	if pageToken != "" {
+		path += fmt.Sprintf("&nextPageToken=%s", url.QueryEscape(pageToken))
+	}
 	return c.search(path, apiVersion3)
 }
 
+// SearchCount returns the approximate number of issues matching the query
+// using v3 version of the Jira POST /search/approximate-count endpoint.
+func (c *Client) SearchCount(jql string) (int, error) {
+	body, err := json.Marshal(struct {
+		JQL string `json:"jql"`
+	}{JQL: jql})
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := c.Post(context.Background(), "/search/approximate-count", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return 0, err
+	}
+	if res == nil {
+		return 0, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return 0, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Count int `json:"count"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.Count, err
+}
+
 // SearchV2 searches an issues using v2 version of the Jira GET /search endpoint.
 func (c *Client) SearchV2(jql string, from, limit uint) (*SearchResult, error) {
 	path := fmt.Sprintf("/search?jql=%s&startAt=%d&maxResults=%d", url.QueryEscape(jql), from, limit)
@@ -2,6 +2,7 @@
 package jira
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -140,3 +141,59 @@ func TestSearch(t *testing.T) {
 	_, err = client.SearchV2("project=TEST", 0, 100)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestSearchPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		assert.Equal(t, url.Values{
+			"jql":           []string{"project=TEST"},
+			"fields":        []string{"*all"},
+			"maxResults":    []string{"50"},
+			"nextPageToken": []string{"page+2"},
+		}, r.URL.Query())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"isLast": false, "nextPageToken": "page-3", "issues": [{"key": "TEST-51"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchPage("project=TEST", "page+2", 50)
+	assert.NoError(t, err)
+	assert.Equal(t, &SearchResult{NextPageToken: "page-3", Issues: []*Issue{{Key: "TEST-51"}}}, actual)
+}
+
+func TestSearchCount(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/approximate-count", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"jql": "project=TEST"}`, string(body))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"count": 1340}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	count, err := client.SearchCount("project=TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, 1340, count)
+
+	unexpectedStatusCode = true
+
+	_, err = client.SearchCount("project=TEST")
+	assert.Error(t, err)
+}
@@ -15,6 +15,9 @@ const (
 	SprintStateFuture = "future"
 )
 
+// sprintHistoryPageSize is the page size of the issues fetched along with the changelog.
+const sprintHistoryPageSize = 50
+
 // SprintResult holds response from /board/{boardID}/sprint endpoint.
 type SprintResult struct {
 	MaxResults int       `json:"maxResults"`
@@ -191,6 +194,81 @@ func (c *Client) SprintIssues(sprintID int, jql string, from, limit uint) (*Sear
 	return &out, err
 }
 
+// SprintIssueHistory fetches all issues in the given sprint along with their changelog.
+//
+// pointsField is the ID of the story points custom field, eg: customfield_10016. The
+// field is not fetched if it is empty. The issues removed from the sprint are not returned.
+func (c *Client) SprintIssueHistory(sprintID int, pointsField string) ([]*IssueHistory, error) {
+	fields := "summary,issuetype,status,created,resolutiondate"
+	if pointsField != "" {
+		fields += "," + pointsField
+	}
+
+	var issues []*IssueHistory
+
+	for {
+		path := fmt.Sprintf(
+			"/sprint/%d/issue?startAt=%d&maxResults=%d&expand=changelog&fields=%s",
+			sprintID, len(issues), sprintHistoryPageSize, url.QueryEscape(fields),
+		)
+		page, total, err := c.sprintIssueHistory(path, pointsField)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page...)
+
+		if len(page) == 0 || len(issues) >= total {
+			return issues, nil
+		}
+	}
+}
+
+func (c *Client) sprintIssueHistory(path, pointsField string) ([]*IssueHistory, int, error) {
+	res, err := c.GetV1(context.Background(), path, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if res == nil {
+		return nil, 0, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, 0, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Total  int `json:"total"`
+		Issues []struct {
+			Key       string          `json:"key"`
+			Fields    json.RawMessage `json:"fields"`
+			Changelog Changelog       `json:"changelog"`
+		} `json:"issues"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, 0, err
+	}
+
+	issues := make([]*IssueHistory, 0, len(out.Issues))
+	for _, iss := range out.Issues {
+		h := IssueHistory{Key: iss.Key, Changelog: iss.Changelog}
+		if err := json.Unmarshal(iss.Fields, &h.Fields); err != nil {
+			return nil, 0, err
+		}
+		if pointsField != "" {
+			var custom map[string]json.RawMessage
+			if err := json.Unmarshal(iss.Fields, &custom); err != nil {
+				return nil, 0, err
+			}
+			// The field is null if the issue is not estimated.
+			_ = json.Unmarshal(custom[pointsField], &h.Points)
+		}
+		issues = append(issues, &h)
+	}
+
+	return issues, out.Total, nil
+}
+
 // SprintIssuesAdd adds issues to the sprint.
 func (c *Client) SprintIssuesAdd(id string, issues ...string) error {
 	path := fmt.Sprintf("/sprint/%s/issue", id)
@@ -414,3 +414,68 @@ func TestEndSprint(t *testing.T) {
 	err = client.EndSprint(5)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestSprintIssueHistory(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint/1/issue", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		assert.Equal(t, url.Values{
+			"startAt":    []string{"0"},
+			"maxResults": []string{"50"},
+			"expand":     []string{"changelog"},
+			"fields":     []string{"summary,issuetype,status,created,resolutiondate,customfield_10016"},
+		}, r.URL.Query())
+
+		resp, err := os.ReadFile("./testdata/sprint-issue-history.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SprintIssueHistory(1, "customfield_10016")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+
+	assert.Equal(t, "TEST-1", actual[0].Key)
+	assert.Equal(t, "Login form", actual[0].Fields.Summary)
+	assert.Equal(t, "Story", actual[0].Fields.IssueType.Name)
+	assert.Equal(t, "done", actual[0].Fields.Status.StatusCategory.Key)
+	assert.Equal(t, "2020-11-20T09:00:00.000+0000", actual[0].Fields.ResolutionDate)
+	assert.Equal(t, 5.0, actual[0].Points)
+	assert.Equal(t, []ChangelogHistory{
+		{
+			Created: "2020-11-12T09:00:00.000+0000",
+			Items: []ChangelogItem{
+				{Field: "Sprint", FieldID: "customfield_10020", To: "1", ToString: "Sprint 1"},
+			},
+		},
+		{
+			Created: "2020-11-20T09:00:00.000+0000",
+			Items: []ChangelogItem{
+				{Field: "status", FieldID: "status", From: "3", FromString: "In Progress", To: "10001", ToString: "Done"},
+			},
+		},
+	}, actual[0].Changelog.Histories)
+
+	assert.Equal(t, "TEST-2", actual[1].Key)
+	assert.Equal(t, "new", actual[1].Fields.Status.StatusCategory.Key)
+	assert.Equal(t, 0.0, actual[1].Points)
+	assert.Empty(t, actual[1].Changelog.Histories)
+
+	unexpectedStatusCode = true
+
+	_, err = client.SprintIssueHistory(1, "customfield_10016")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
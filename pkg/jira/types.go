@@ -11,10 +11,12 @@ const (
 	AuthTypeBearer AuthType = "bearer"
 	// AuthTypeMTLS is a mTLS auth.
 	AuthTypeMTLS AuthType = "mtls"
+	// AuthTypeOAuth2 is an OAuth 2.0 (3LO) auth for cloud.
+	AuthTypeOAuth2 AuthType = "oauth2"
 )
 
 // AuthType is a jira authentication type.
-// Currently supports basic and bearer (PAT).
+// Currently supports basic, bearer (PAT), mtls and oauth2.
 // Defaults to basic for empty or invalid value.
 type AuthType string
 
@@ -61,6 +63,7 @@ type Epic struct {
 
 // Issue holds issue info.
 type Issue struct {
+	ID     string      `json:"id,omitempty"`
 	Key    string      `json:"key"`
 	Fields IssueFields `json:"fields"`
 }
@@ -111,7 +114,7 @@ type IssueFields struct {
 		} `json:"comments"`
 		Total int `json:"total"`
 	} `json:"comment"`
-	Subtasks   []Issue
+	Subtasks   []Issue `json:"subtasks"`
 	IssueLinks []struct {
 		ID       string `json:"id"`
 		LinkType struct {
@@ -176,11 +179,58 @@ type Sprint struct {
 	BoardID      int    `json:"originBoardId,omitempty"`
 }
 
+// IssueHistory holds an issue along with its change history.
+type IssueHistory struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary   string    `json:"summary"`
+		IssueType IssueType `json:"issuetype"`
+		Status    struct {
+			Name           string `json:"name"`
+			StatusCategory struct {
+				Key string `json:"key"`
+			} `json:"statusCategory"`
+		} `json:"status"`
+		Created        string `json:"created"`
+		ResolutionDate string `json:"resolutiondate"`
+	} `json:"fields"`
+	// Points is the value of the story points field, if requested.
+	Points    float64   `json:"points"`
+	Changelog Changelog `json:"changelog"`
+}
+
+// Changelog holds the change history of an issue.
+type Changelog struct {
+	Histories []ChangelogHistory `json:"histories"`
+}
+
+// ChangelogHistory holds the fields changed at once.
+type ChangelogHistory struct {
+	Created string          `json:"created"`
+	Items   []ChangelogItem `json:"items"`
+}
+
+// ChangelogItem holds the change of a field.
+//
+// From and To are the IDs, eg: of the sprints separated by a comma,
+// while FromString and ToString are the display values.
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldID    string `json:"fieldId,omitempty"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
 // Transition holds issue transition info.
 type Transition struct {
 	ID          json.Number `json:"id"`
 	Name        string      `json:"name"`
 	IsAvailable bool        `json:"isAvailable"`
+	To          *struct {
+		Name string `json:"name"`
+	} `json:"to,omitempty"`
 }
 
 // User holds user info.
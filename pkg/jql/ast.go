@@ -0,0 +1,127 @@
+package jql
+
+import (
+	"strings"
+)
+
+// Logical operators.
+const (
+	OperatorAnd = "AND"
+	OperatorOr  = "OR"
+)
+
+// Query is a parsed JQL query.
+type Query struct {
+	// Where is nil if the query only has the ORDER BY clause.
+	Where   Expr
+	OrderBy []OrderField
+}
+
+// Expr is a node of the query condition: a *Clause, a *LogicalExpr or a *NotExpr.
+type Expr interface {
+	expr()
+}
+
+// LogicalExpr combines the expressions with AND or OR operator.
+type LogicalExpr struct {
+	Op    string
+	Exprs []Expr
+}
+
+// NotExpr negates the expression.
+type NotExpr struct {
+	Expr Expr
+}
+
+// Clause compares a field with a value, eg: `status IN (Open, "In Progress")`.
+//
+// Value is nil for the CHANGED operator. Predicates are only
+// used with the history operators WAS and CHANGED.
+type Clause struct {
+	Field      *Literal
+	Operator   string
+	Value      Value
+	Predicates []*Predicate
+}
+
+// Predicate narrows down the history operators, eg: `BY currentUser()`.
+type Predicate struct {
+	Name  string
+	Value Value
+}
+
+// Value is an operand of the clause: a *Literal, a *Function or a *List.
+type Value interface {
+	value()
+}
+
+// Literal is a single value. Quoted is set if the value was quoted in the query.
+type Literal struct {
+	Value  string
+	Quoted bool
+}
+
+// Function is a function call, eg: `startOfDay(-1d)`.
+type Function struct {
+	Name string
+	Args []*Literal
+}
+
+// List is a list of values used with IN operator.
+type List struct {
+	Values []Value
+}
+
+// OrderField is a field in the ORDER BY clause.
+type OrderField struct {
+	Field     *Literal
+	Direction string
+}
+
+func (*LogicalExpr) expr() {}
+func (*NotExpr) expr()     {}
+func (*Clause) expr()      {}
+
+func (*Literal) value()  {}
+func (*Function) value() {}
+func (*List) value()     {}
+
+// HasField checks if the field is used in any clause of the query.
+// The field name is case-insensitive.
+func (q *Query) HasField(name string) bool {
+	found := false
+	walk(q.Where, func(c *Clause) {
+		if strings.EqualFold(c.Field.Value, name) {
+			found = true
+		}
+	})
+	return found
+}
+
+// Fields returns the fields used in the clauses in order of appearance.
+func (q *Query) Fields() []string {
+	var (
+		fields = make([]string, 0)
+		seen   = make(map[string]bool)
+	)
+	walk(q.Where, func(c *Clause) {
+		if key := strings.ToLower(c.Field.Value); !seen[key] {
+			seen[key] = true
+			fields = append(fields, c.Field.Value)
+		}
+	})
+	return fields
+}
+
+func walk(e Expr, fn func(*Clause)) {
+	switch n := e.(type) {
+	case *Clause:
+		fn(n)
+	case *NotExpr:
+		walk(n.Expr, fn)
+	case *LogicalExpr:
+		for _, x := range n.Exprs {
+			walk(x, fn)
+		}
+	}
+}
@@ -1,7 +1,10 @@
-// Package jql is a very simple JQL query builder that cannot do a lot at the moment.
+// Package jql builds, parses and formats JQL queries.
 //
-// There is no JQL syntax check and relies on the package user to construct a valid query.
+// JQL is a simple query builder used to construct queries from the command flags.
+// It cannot nest AND and OR groups, but the raw queries passed to it are put in
+// parentheses when needed, so that they keep their meaning.
 //
-// It cannot combine AND and OR query currently. That means you cannot construct a query like the one below:
-// project="JQL" AND issue in openSprints() AND (type="Story" OR resolution="Done")
+// Parse reads a query into a syntax tree that can be inspected and printed back
+// with Query.String and Query.Pretty. Merge combines the syntax trees of the
+// queries with AND.
 package jql
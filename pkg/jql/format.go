@@ -0,0 +1,194 @@
+package jql
+
+import (
+	"strings"
+	"unicode"
+)
+
+// String returns the query in a canonical single line form.
+func (q *Query) String() string {
+	var b strings.Builder
+	if q.Where != nil {
+		writeExpr(&b, q.Where, -1)
+	}
+	writeOrderBy(&b, q.OrderBy, " ")
+	return strings.TrimSpace(b.String())
+}
+
+// Pretty returns the query formatted over multiple lines. Each operand of
+// the top level AND and OR is put on a separate line and the nested groups
+// are indented.
+func (q *Query) Pretty() string {
+	var b strings.Builder
+	if q.Where != nil {
+		writeExpr(&b, q.Where, 0)
+	}
+	writeOrderBy(&b, q.OrderBy, "\n")
+	return strings.TrimSpace(b.String())
+}
+
+// Format parses and pretty prints the query, see Query.Pretty.
+func Format(query string) (string, error) {
+	q, err := Parse(query)
+	if err != nil {
+		return "", err
+	}
+	return q.Pretty(), nil
+}
+
+// Quote quotes the value if it can't be used in the query as is,
+// eg: if it contains spaces or is a reserved word.
+func Quote(s string) string {
+	if !needsQuote(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func needsQuote(s string) bool {
+	if s == "" || keywords[strings.ToUpper(s)] {
+		return true
+	}
+	return strings.ContainsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(`=!<>~()&|,"'\`, r)
+	})
+}
+
+// writeExpr writes the expression in a single line if the depth is negative,
+// otherwise in multiple lines indented with the depth.
+func writeExpr(b *strings.Builder, e Expr, depth int) {
+	switch n := e.(type) {
+	case *Clause:
+		writeClause(b, n)
+	case *NotExpr:
+		b.WriteString("NOT ")
+		if _, ok := n.Expr.(*LogicalExpr); ok {
+			writeGroup(b, n.Expr, depth)
+		} else {
+			writeExpr(b, n.Expr, depth)
+		}
+	case *LogicalExpr:
+		for i, x := range n.Exprs {
+			if i > 0 {
+				if depth < 0 {
+					b.WriteString(" ")
+				} else {
+					b.WriteString("\n" + strings.Repeat("  ", depth))
+				}
+				b.WriteString(n.Op + " ")
+			}
+			// Nested groups are always in parentheses, even if the precedence
+			// doesn't require it, so that the intent is clear.
+			if _, ok := x.(*LogicalExpr); ok {
+				writeGroup(b, x, depth)
+			} else {
+				writeExpr(b, x, depth)
+			}
+		}
+	}
+}
+
+func writeGroup(b *strings.Builder, e Expr, depth int) {
+	if depth < 0 {
+		b.WriteString("(")
+		writeExpr(b, e, depth)
+		b.WriteString(")")
+		return
+	}
+
+	indent := strings.Repeat("  ", depth+1)
+	b.WriteString("(\n" + indent)
+	writeExpr(b, e, depth+1)
+	b.WriteString("\n" + strings.Repeat("  ", depth) + ")")
+}
+
+func writeClause(b *strings.Builder, c *Clause) {
+	writeLiteral(b, c.Field)
+	b.WriteString(" " + c.Operator)
+	if c.Value != nil {
+		b.WriteString(" ")
+		writeValue(b, c.Value)
+	}
+	for _, p := range c.Predicates {
+		b.WriteString(" " + p.Name + " ")
+		writeValue(b, p.Value)
+	}
+}
+
+func writeValue(b *strings.Builder, v Value) {
+	switch n := v.(type) {
+	case *Literal:
+		writeLiteral(b, n)
+	case *Function:
+		b.WriteString(n.Name + "(")
+		for i, a := range n.Args {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeLiteral(b, a)
+		}
+		b.WriteString(")")
+	case *List:
+		b.WriteString("(")
+		for i, x := range n.Values {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeValue(b, x)
+		}
+		b.WriteString(")")
+	}
+}
+
+func writeLiteral(b *strings.Builder, l *Literal) {
+	switch {
+	case l.Quoted:
+		// Keep the quotes, since quoting changes the meaning of some values,
+		// eg: "EMPTY" is a string while EMPTY is a keyword.
+		q := Quote(l.Value)
+		if q == l.Value {
+			q = `"` + q + `"`
+		}
+		b.WriteString(q)
+	case l.Value == "EMPTY" || l.Value == "NULL":
+		b.WriteString(l.Value)
+	default:
+		b.WriteString(Quote(l.Value))
+	}
+}
+
+func writeOrderBy(b *strings.Builder, fields []OrderField, sep string) {
+	if len(fields) == 0 {
+		return
+	}
+
+	b.WriteString(sep + "ORDER BY ")
+	for i, f := range fields {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		writeLiteral(b, f.Field)
+		if f.Direction != "" {
+			b.WriteString(" " + f.Direction)
+		}
+	}
+}
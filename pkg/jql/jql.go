@@ -2,7 +2,6 @@ package jql
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
 )
 
@@ -18,28 +17,41 @@ type GroupFunc func()
 
 // JQL is a jira query language constructor.
 type JQL struct {
-	project string
-	filters []string
-	orderBy string
+	project    string
+	filters    []filter
+	orderBy    string
+	rawOrderBy string
+}
+
+// filter is a condition of the query along with its top level
+// logical operator, used to decide if it needs parentheses.
+type filter struct {
+	q  string
+	op string
 }
 
 // NewJQL initializes jql query builder.
 func NewJQL(project string) *JQL {
-	return &JQL{
-		project: project,
-		filters: []string{fmt.Sprintf("project=%q", project)},
-	}
+	j := JQL{project: project}
+	j.add(fmt.Sprintf("project=%q", project))
+	return &j
+}
+
+// NewGroup initializes a builder for a group of filters without the project context.
+// The group is added to the query with Group.
+func NewGroup() *JQL {
+	return &JQL{}
 }
 
 // History search through user issue history.
 func (j *JQL) History() *JQL {
-	j.filters = append(j.filters, "issue IN issueHistory()")
+	j.add("issue IN issueHistory()")
 	return j
 }
 
 // Watching search through watched issues.
 func (j *JQL) Watching() *JQL {
-	j.filters = append(j.filters, "issue IN watchedIssues()")
+	j.add("issue IN watchedIssues()")
 	return j
 }
 
@@ -64,7 +76,7 @@ func (j *JQL) FilterBy(field, value string) *JQL {
 			q = fmt.Sprintf("%s=%q", field, value)
 		}
 
-		j.filters = append(j.filters, q)
+		j.add(q)
 	}
 	return j
 }
@@ -80,7 +92,7 @@ func (j *JQL) Gt(field, value string, wrap bool) *JQL {
 			q = fmt.Sprintf("%s>%s", field, value)
 		}
 
-		j.filters = append(j.filters, q)
+		j.add(q)
 	}
 	return j
 }
@@ -96,7 +108,7 @@ func (j *JQL) Gte(field, value string, wrap bool) *JQL {
 			q = fmt.Sprintf("%s>=%s", field, value)
 		}
 
-		j.filters = append(j.filters, q)
+		j.add(q)
 	}
 	return j
 }
@@ -112,7 +124,39 @@ func (j *JQL) Lt(field, value string, wrap bool) *JQL {
 			q = fmt.Sprintf("%s<%s", field, value)
 		}
 
-		j.filters = append(j.filters, q)
+		j.add(q)
+	}
+	return j
+}
+
+// Lte is a less than and equals filter.
+func (j *JQL) Lte(field, value string, wrap bool) *JQL {
+	if field != "" && value != "" {
+		var q string
+
+		if wrap {
+			q = fmt.Sprintf("%s<=%q", field, value)
+		} else {
+			q = fmt.Sprintf("%s<=%s", field, value)
+		}
+
+		j.add(q)
+	}
+	return j
+}
+
+// Contains is a text search filter.
+func (j *JQL) Contains(field, value string) *JQL {
+	if field != "" && value != "" {
+		j.add(fmt.Sprintf("%s ~ %q", field, value))
+	}
+	return j
+}
+
+// InFunc constructs a query with IN clause for a function, eg: `sprint IN openSprints()`.
+func (j *JQL) InFunc(field, fn string) *JQL {
+	if field != "" && fn != "" {
+		j.add(fmt.Sprintf("%s IN %s", field, fn))
 	}
 	return j
 }
@@ -132,7 +176,7 @@ func (j *JQL) In(field string, value ...string) *JQL {
 		}
 		q.WriteString(")")
 
-		j.filters = append(j.filters, q.String())
+		j.add(q.String())
 	}
 	return j
 }
@@ -152,7 +196,7 @@ func (j *JQL) NotIn(field string, value ...string) *JQL {
 		}
 		q.WriteString(")")
 
-		j.filters = append(j.filters, q.String())
+		j.add(q.String())
 	}
 	return j
 }
@@ -163,6 +207,26 @@ func (j *JQL) OrderBy(field, dir string) *JQL {
 	return j
 }
 
+// ThenBy adds another field to the order set with OrderBy.
+func (j *JQL) ThenBy(field, dir string) *JQL {
+	if j.orderBy == "" {
+		return j.OrderBy(field, dir)
+	}
+	j.orderBy += fmt.Sprintf(", %s %s", field, dir)
+	return j
+}
+
+// Group adds the filters of the group as a single filter. The group is put
+// in parentheses if it was combined with OR operator and the query with AND.
+func (j *JQL) Group(g *JQL) *JQL {
+	if len(g.filters) == 0 {
+		return j
+	}
+	g.mergeFilters(OperatorAnd)
+	j.filters = append(j.filters, g.filters[0])
+	return j
+}
+
 // And combines filter with AND operator.
 func (j *JQL) And(fn GroupFunc) *JQL {
 	fn()
@@ -178,15 +242,21 @@ func (j *JQL) Or(fn GroupFunc) *JQL {
 }
 
 // Raw sets the passed JQL query along with project context.
+//
+// The project filter is dropped if the query filters by project itself.
+// The ORDER BY clause of the query, if any, takes precedence over OrderBy.
 func (j *JQL) Raw(q string) *JQL {
-	q = strings.TrimSpace(q)
+	q, orderBy := splitOrderBy(q)
+	if orderBy != "" {
+		j.rawOrderBy = "ORDER BY " + orderBy
+	}
 	if q == "" {
 		return j
 	}
 	if hasProjectFilter(q) {
-		j.filters = j.filters[1:]
+		j.removeProjectFilter()
 	}
-	j.filters = append(j.filters, q)
+	j.filters = append(j.filters, filter{q: q, op: topLevelOp(q)})
 	return j
 }
 
@@ -195,38 +265,62 @@ func (j *JQL) String() string {
 	return j.compile()
 }
 
+func (j *JQL) add(q string) {
+	j.filters = append(j.filters, filter{q: q})
+}
+
+func (j *JQL) removeProjectFilter() {
+	pf := fmt.Sprintf("project=%q", j.project)
+	for i, f := range j.filters {
+		if f.q == pf {
+			j.filters = append(j.filters[:i], j.filters[i+1:]...)
+			return
+		}
+	}
+}
+
 func (j *JQL) mergeFilters(separator string) {
 	fLen := len(j.filters)
+	if fLen == 0 {
+		return
+	}
 
 	var qs strings.Builder
 
-	for i, filter := range j.filters {
-		qs.WriteString(filter)
+	for i, f := range j.filters {
+		// OR binds weaker than AND, so the query would change its meaning without parentheses.
+		if f.op == OperatorOr && separator == OperatorAnd && fLen > 1 {
+			fmt.Fprintf(&qs, "(%s)", f.q)
+		} else {
+			qs.WriteString(f.q)
+		}
 
 		if i != fLen-1 {
 			fmt.Fprintf(&qs, " %s ", separator)
 		}
 	}
 
-	s := qs.String()
-
-	if s != "" {
-		j.filters = nil
-		j.filters = append(j.filters, qs.String())
+	op := j.filters[0].op
+	if fLen > 1 {
+		op = separator
 	}
+	j.filters = []filter{{q: qs.String(), op: op}}
 }
 
 func (j *JQL) compile() string {
-	q := strings.Join(j.filters, " ")
-	if j.orderBy != "" {
-		q += " " + j.orderBy
+	qs := make([]string, 0, len(j.filters))
+	for _, f := range j.filters {
+		qs = append(qs, f.q)
 	}
+	q := strings.Join(qs, " ")
 
-	return q
-}
+	orderBy := j.orderBy
+	if j.rawOrderBy != "" {
+		orderBy = j.rawOrderBy
+	}
+	if orderBy != "" {
+		q = strings.TrimSpace(q + " " + orderBy)
+	}
 
-func hasProjectFilter(str string) bool {
-	regx := "(?i)((project)[\\s]*?={0,1}\\b)[^'.']"
-	m, _ := regexp.MatchString(regx, str)
-	return m
+	return q
 }
@@ -247,6 +247,61 @@ func TestJQL(t *testing.T) {
 			},
 			expected: "project=\"TEST\" AND type=\"Story\" AND labels IN (\"first\", \"second\") AND labels NOT IN (\"third\", \"fourth\")",
 		},
+		{
+			name: "it queries with less than or equals, contains and function filters",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.And(func() {
+					jql.
+						Lte("due", "endOfWeek()", false).
+						Lte("updated", "2020-11-11", true).
+						Contains("summary", "cli").
+						InFunc("sprint", "openSprints()")
+				})
+				return jql
+			},
+			expected: "project=\"TEST\" AND due<=endOfWeek() AND updated<=\"2020-11-11\" AND " +
+				"summary ~ \"cli\" AND sprint IN openSprints()",
+		},
+		{
+			name: "it orders by multiple fields",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.ThenBy("priority", "DESC").ThenBy("created", "ASC")
+				return jql
+			},
+			expected: "project=\"TEST\" ORDER BY priority DESC, created ASC",
+		},
+		{
+			name: "it queries with or group in and condition",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.And(func() {
+					g := NewGroup()
+					g.Or(func() {
+						g.FilterBy("assignee", "a@b.c").FilterBy("reporter", "a@b.c")
+					})
+					jql.FilterBy("type", "Story").Group(g).Group(NewGroup())
+				})
+				return jql
+			},
+			expected: "project=\"TEST\" AND type=\"Story\" AND (assignee=\"a@b.c\" OR reporter=\"a@b.c\")",
+		},
+		{
+			name: "it doesn't put a single filter group in parentheses",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.And(func() {
+					g := NewGroup()
+					g.Or(func() {
+						g.FilterBy("assignee", "a@b.c")
+					})
+					jql.Group(g)
+				})
+				return jql
+			},
+			expected: "project=\"TEST\" AND assignee=\"a@b.c\"",
+		},
 		{
 			name: "it queries with raw jql",
 			initialize: func() *JQL {
@@ -299,6 +354,65 @@ func TestJQL(t *testing.T) {
 			},
 			expected: "type=\"Story\" OR summary ~ cli AND project IN (TEST1,TEST2)",
 		},
+		{
+			name: "it groups raw jql with or filter in and condition",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.And(func() {
+					jql.Raw("summary ~ cli OR priority = high").
+						FilterBy("type", "Story")
+				})
+				return jql
+			},
+			expected: "project=\"TEST\" AND (summary ~ cli OR priority = high) AND type=\"Story\"",
+		},
+		{
+			name: "it doesn't group raw jql with or filter in parentheses",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.And(func() {
+					jql.Raw("(summary ~ cli OR priority = high) AND labels = x")
+				})
+				return jql
+			},
+			expected: "project=\"TEST\" AND (summary ~ cli OR priority = high) AND labels = x",
+		},
+		{
+			name: "it uses order by of raw jql",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.Raw("summary ~ cli order by rank")
+				jql.And(func() {
+					jql.FilterBy("type", "Story")
+				})
+				jql.OrderBy("created", "DESC")
+				return jql
+			},
+			expected: "project=\"TEST\" AND summary ~ cli AND type=\"Story\" ORDER BY rank",
+		},
+		{
+			name: "it queries with raw jql having order by only",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.Raw("ORDER BY rank ASC")
+				jql.And(func() {
+					jql.FilterBy("type", "Story")
+				})
+				return jql
+			},
+			expected: "project=\"TEST\" AND type=\"Story\" ORDER BY rank ASC",
+		},
+		{
+			name: "it keeps the project filter if raw jql mentions project in a string",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.And(func() {
+					jql.Raw(`summary ~ "project = TEST1"`)
+				})
+				return jql
+			},
+			expected: "project=\"TEST\" AND summary ~ \"project = TEST1\"",
+		},
 	}
 
 	for _, tc := range cases {
@@ -370,6 +484,26 @@ func TestHasProject(t *testing.T) {
 			input:    "projectType=\"classic\" AND type=\"Story\" AND assignee IS EMPTY",
 			expected: false,
 		},
+		{
+			input:    "summary ~ \"project = TEST\"",
+			expected: false,
+		},
+		{
+			input:    "assignee = currentUser() ORDER BY project",
+			expected: false,
+		},
+		{
+			input:    "\"project\" = TEST",
+			expected: true,
+		},
+		{
+			input:    "'Project' IN (TEST, DEMO)",
+			expected: true,
+		},
+		{
+			input:    "summary ~ \"project\" AND type = Bug",
+			expected: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,192 @@
+package jql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind is a kind of the JQL token.
+type TokenKind int
+
+// Kinds of the JQL tokens.
+const (
+	TokenEOF TokenKind = iota
+	TokenIdent
+	TokenString
+	TokenKeyword
+	TokenOperator
+	TokenLParen
+	TokenRParen
+	TokenComma
+)
+
+// keywords are the reserved words with a special meaning in the query.
+var keywords = map[string]bool{
+	"AND": true, "OR": true, "NOT": true,
+	"IN": true, "IS": true, "WAS": true, "CHANGED": true,
+	"EMPTY": true, "NULL": true,
+	"ORDER": true, "BY": true, "ASC": true, "DESC": true,
+	"AFTER": true, "BEFORE": true, "DURING": true, "ON": true, "FROM": true, "TO": true,
+}
+
+// Token is a lexical token of the query.
+//
+// Value of a string token is unquoted and unescaped, value of a keyword
+// is in upper case. Offset and End are byte offsets in the query.
+type Token struct {
+	Kind   TokenKind
+	Value  string
+	Offset int
+	End    int
+}
+
+// Is checks if the token is the given keyword.
+func (t Token) Is(keyword string) bool {
+	return t.Kind == TokenKeyword && t.Value == keyword
+}
+
+// Lex splits the query into tokens. The last token is always TokenEOF.
+//
+// The tokens read so far are returned along with the error, so that
+// a partially invalid query can still be inspected.
+func Lex(query string) ([]Token, error) {
+	l := lexer{input: query}
+	for {
+		tok, err := l.next()
+		if err != nil {
+			l.tokens = append(l.tokens, Token{Kind: TokenEOF, Offset: len(query), End: len(query)})
+			return l.tokens, err
+		}
+		l.tokens = append(l.tokens, tok)
+		if tok.Kind == TokenEOF {
+			return l.tokens, nil
+		}
+	}
+}
+
+type lexer struct {
+	input  string
+	pos    int
+	tokens []Token
+}
+
+func (l *lexer) peek(n int) string {
+	end := min(l.pos+n, len(l.input))
+	return l.input[l.pos:end]
+}
+
+func (l *lexer) next() (Token, error) {
+	for l.pos < len(l.input) {
+		r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		l.pos += size
+	}
+
+	start := l.pos
+	tok := func(kind TokenKind, value string, size int) (Token, error) {
+		l.pos += size
+		return Token{Kind: kind, Value: value, Offset: start, End: l.pos}, nil
+	}
+
+	if l.pos == len(l.input) {
+		return tok(TokenEOF, "", 0)
+	}
+
+	switch two := l.peek(2); two {
+	case "!=", "!~", ">=", "<=":
+		return tok(TokenOperator, two, 2)
+	case "&&":
+		return tok(TokenKeyword, "AND", 2)
+	case "||":
+		return tok(TokenKeyword, "OR", 2)
+	}
+
+	switch c := l.input[l.pos]; c {
+	case '=', '~', '>', '<':
+		return tok(TokenOperator, string(c), 1)
+	case '&':
+		return tok(TokenKeyword, "AND", 1)
+	case '|':
+		return tok(TokenKeyword, "OR", 1)
+	case '!':
+		return tok(TokenKeyword, "NOT", 1)
+	case '(':
+		return tok(TokenLParen, "(", 1)
+	case ')':
+		return tok(TokenRParen, ")", 1)
+	case ',':
+		return tok(TokenComma, ",", 1)
+	case '"', '\'':
+		return l.string(c)
+	}
+
+	return l.ident()
+}
+
+func (l *lexer) string(quote byte) (Token, error) {
+	start := l.pos
+	l.pos++
+
+	var b strings.Builder
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+		switch {
+		case c == quote:
+			l.pos++
+			return Token{Kind: TokenString, Value: b.String(), Offset: start, End: l.pos}, nil
+		case c == '\\' && l.pos+1 < len(l.input):
+			b.WriteByte(unescape(l.input[l.pos+1]))
+			l.pos += 2
+		default:
+			b.WriteByte(c)
+			l.pos++
+		}
+	}
+	return Token{}, &SyntaxError{
+		Msg:    fmt.Sprintf("missing closing quote %c", quote),
+		Offset: start,
+		End:    len(l.input),
+		query:  l.input,
+	}
+}
+
+func (l *lexer) ident() (Token, error) {
+	start := l.pos
+
+	var b strings.Builder
+	for l.pos < len(l.input) {
+		r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		if unicode.IsSpace(r) || strings.ContainsRune(`=!<>~()&|,"'`, r) {
+			break
+		}
+		if r == '\\' && l.pos+1 < len(l.input) {
+			b.WriteByte(unescape(l.input[l.pos+1]))
+			l.pos += 2
+			continue
+		}
+		b.WriteRune(r)
+		l.pos += size
+	}
+
+	val := b.String()
+	if upper := strings.ToUpper(val); keywords[upper] {
+		return Token{Kind: TokenKeyword, Value: upper, Offset: start, End: l.pos}, nil
+	}
+	return Token{Kind: TokenIdent, Value: val, Offset: start, End: l.pos}, nil
+}
+
+func unescape(c byte) byte {
+	switch c {
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case 'r':
+		return '\r'
+	}
+	return c
+}
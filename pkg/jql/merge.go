@@ -0,0 +1,104 @@
+package jql
+
+import (
+	"strings"
+)
+
+// Merge parses the queries and combines their conditions with AND operator.
+//
+// The operands of a top level AND are merged into a single group and any
+// other condition is kept as one operand, so that a query with a top level
+// OR keeps its meaning. If more than one query has an ORDER BY clause, the
+// last one wins, since the later queries are usually the more specific ones.
+func Merge(queries ...string) (string, error) {
+	var (
+		merged Query
+		exprs  []Expr
+	)
+
+	for _, s := range queries {
+		q, err := Parse(s)
+		if err != nil {
+			return "", err
+		}
+		if l, ok := q.Where.(*LogicalExpr); ok && l.Op == OperatorAnd {
+			exprs = append(exprs, l.Exprs...)
+		} else if q.Where != nil {
+			exprs = append(exprs, q.Where)
+		}
+		if len(q.OrderBy) > 0 {
+			merged.OrderBy = q.OrderBy
+		}
+	}
+
+	switch len(exprs) {
+	case 0:
+	case 1:
+		merged.Where = exprs[0]
+	default:
+		merged.Where = &LogicalExpr{Op: OperatorAnd, Exprs: exprs}
+	}
+	return merged.String(), nil
+}
+
+// splitOrderBy splits the query into the condition and the fields of the ORDER BY clause.
+func splitOrderBy(q string) (string, string) {
+	tokens, _ := Lex(q)
+
+	depth := 0
+	for i, tok := range tokens {
+		switch {
+		case tok.Kind == TokenLParen:
+			depth++
+		case tok.Kind == TokenRParen:
+			depth--
+		case depth == 0 && tok.Is("ORDER") && tokens[i+1].Is("BY"):
+			return strings.TrimSpace(q[:tok.Offset]), strings.TrimSpace(q[tokens[i+1].End:])
+		}
+	}
+	return strings.TrimSpace(q), ""
+}
+
+// topLevelOp returns the logical operator with the lowest precedence that
+// is not in parentheses, or an empty string if there is no such operator.
+func topLevelOp(q string) string {
+	tokens, _ := Lex(q)
+
+	var (
+		op    string
+		depth int
+	)
+	for _, tok := range tokens {
+		switch {
+		case tok.Kind == TokenLParen:
+			depth++
+		case tok.Kind == TokenRParen:
+			depth--
+		case depth == 0 && tok.Is(OperatorOr):
+			return OperatorOr
+		case depth == 0 && tok.Is(OperatorAnd):
+			op = OperatorAnd
+		}
+	}
+	return op
+}
+
+// hasProjectFilter checks if the query has a clause on the project field.
+//
+// It only looks at the tokens, so that it also works
+// for the queries that are incomplete or invalid.
+func hasProjectFilter(q string) bool {
+	tokens, _ := Lex(q)
+
+	for i, tok := range tokens {
+		if (tok.Kind != TokenIdent && tok.Kind != TokenString) || !strings.EqualFold(tok.Value, "project") {
+			continue
+		}
+		next := tokens[i+1]
+		if next.Kind == TokenOperator || next.Is("IN") || next.Is("NOT") || next.Is("IS") ||
+			next.Is("WAS") || next.Is("CHANGED") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package jql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    []string
+		expected string
+	}{
+		{
+			name:     "it merges queries with and",
+			input:    []string{"project = TEST", `text ~ "cli"`},
+			expected: `project = TEST AND text ~ "cli"`,
+		},
+		{
+			name:     "it groups queries with top level or",
+			input:    []string{"a = 1 OR b = 2", "c = 3 AND (d = 4 OR e = 5)"},
+			expected: "(a = 1 OR b = 2) AND c = 3 AND (d = 4 OR e = 5)",
+		},
+		{
+			name:     "it doesn't group a single query",
+			input:    []string{"a = 1 OR b = 2", "  "},
+			expected: "a = 1 OR b = 2",
+		},
+		{
+			name:     "it moves order by to the end",
+			input:    []string{"a = 1 ORDER BY rank", "b = 2"},
+			expected: "a = 1 AND b = 2 ORDER BY rank",
+		},
+		{
+			name:     "the last order by wins",
+			input:    []string{"a = 1 ORDER BY rank", "b = 2 order by created DESC"},
+			expected: "a = 1 AND b = 2 ORDER BY created DESC",
+		},
+		{
+			name:     "it ignores or and order by in strings",
+			input:    []string{`summary ~ "a OR b ORDER BY c"`, "b = 2"},
+			expected: `summary ~ "a OR b ORDER BY c" AND b = 2`,
+		},
+		{
+			name:     "it merges order by only",
+			input:    []string{"ORDER BY rank"},
+			expected: "ORDER BY rank",
+		},
+		{
+			name:     "it keeps not and nested groups",
+			input:    []string{"NOT (a = 1 OR b = 2)", "c IN (x, 'y z') AND d = 4"},
+			expected: `NOT (a = 1 OR b = 2) AND c IN (x, "y z") AND d = 4`,
+		},
+		{
+			name:     "it merges nothing",
+			input:    []string{"", "  "},
+			expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			q, err := Merge(tc.input...)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, q)
+		})
+	}
+}
+
+func TestMergeInvalidQuery(t *testing.T) {
+	t.Parallel()
+
+	_, err := Merge("a = 1", "b = (2")
+	assert.EqualError(t, err, `expecting , or ) but got end of query at character 7`)
+}
+
+func TestTopLevelOp(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", topLevelOp("a = 1"))
+	assert.Equal(t, OperatorAnd, topLevelOp("a = 1 AND (b = 2 OR c = 3)"))
+	assert.Equal(t, OperatorOr, topLevelOp("a = 1 AND b = 2 || c = 3"))
+	assert.Equal(t, "", topLevelOp("(a = 1 OR b = 2)"))
+}
@@ -0,0 +1,330 @@
+package jql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// historyPredicates can follow the WAS and CHANGED operators.
+var historyPredicates = map[string]bool{
+	"AFTER": true, "BEFORE": true, "DURING": true, "ON": true, "BY": true, "FROM": true, "TO": true,
+}
+
+// SyntaxError is an error in the query syntax.
+// Offset and End are byte offsets of the offending token in the query.
+type SyntaxError struct {
+	Msg    string
+	Offset int
+	End    int
+
+	query string
+}
+
+// Error implements error interface.
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at character %d", e.Msg, e.Position().Offset+1)
+}
+
+// Position returns the position of the offending token in runes, see Highlight.
+func (e *SyntaxError) Position() Position {
+	return Position{
+		Offset: len([]rune(e.query[:e.Offset])),
+		Length: max(len([]rune(e.query[e.Offset:e.End])), 1),
+	}
+}
+
+// Parse parses the query.
+func Parse(query string) (*Query, error) {
+	tokens, err := Lex(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{query: query, tokens: tokens}
+
+	return p.parseQuery()
+}
+
+type parser struct {
+	query  string
+	tokens []Token
+	pos    int
+}
+
+func (p *parser) peek() Token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() Token {
+	tok := p.tokens[p.pos]
+	if tok.Kind != TokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) errorf(tok Token, format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	if tok.Kind == TokenEOF {
+		msg += " but got end of query"
+	} else {
+		msg += fmt.Sprintf(" but got %q", p.query[tok.Offset:tok.End])
+	}
+	return &SyntaxError{Msg: msg, Offset: tok.Offset, End: tok.End, query: p.query}
+}
+
+func (p *parser) parseQuery() (*Query, error) {
+	var (
+		q   Query
+		err error
+	)
+
+	if tok := p.peek(); tok.Kind != TokenEOF && !tok.Is("ORDER") {
+		if q.Where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Is("ORDER") {
+		p.next()
+		if tok := p.next(); !tok.Is("BY") {
+			return nil, p.errorf(tok, "expecting BY")
+		}
+		if q.OrderBy, err = p.parseOrderBy(); err != nil {
+			return nil, err
+		}
+	}
+
+	if tok := p.peek(); tok.Kind != TokenEOF {
+		return nil, p.errorf(tok, "expecting AND, OR or ORDER BY")
+	}
+	return &q, nil
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	return p.parseLogical(OperatorOr, p.parseAnd)
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	return p.parseLogical(OperatorAnd, p.parseNot)
+}
+
+func (p *parser) parseLogical(op string, operand func() (Expr, error)) (Expr, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []Expr{first}
+	for p.peek().Is(op) {
+		p.next()
+		e, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+	}
+
+	if len(exprs) == 1 {
+		return first, nil
+	}
+	return &LogicalExpr{Op: op, Exprs: exprs}, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if !p.peek().Is("NOT") {
+		return p.parsePrimary()
+	}
+	p.next()
+
+	e, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	return &NotExpr{Expr: e}, nil
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	if p.peek().Kind != TokenLParen {
+		return p.parseClause()
+	}
+	p.next()
+
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.next(); tok.Kind != TokenRParen {
+		return nil, p.errorf(tok, "expecting )")
+	}
+	return e, nil
+}
+
+func (p *parser) parseField() (*Literal, error) {
+	tok := p.next()
+	switch tok.Kind {
+	case TokenIdent:
+		return &Literal{Value: tok.Value}, nil
+	case TokenString:
+		return &Literal{Value: tok.Value, Quoted: true}, nil
+	}
+	return nil, p.errorf(tok, "expecting a field name")
+}
+
+func (p *parser) parseClause() (Expr, error) {
+	field, err := p.parseField()
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := p.parseOperator()
+	if err != nil {
+		return nil, err
+	}
+
+	c := Clause{Field: field, Operator: op}
+
+	if op != "CHANGED" {
+		if c.Value, err = p.parseValue(); err != nil {
+			return nil, err
+		}
+	}
+
+	if op == "CHANGED" || strings.HasPrefix(op, "WAS") {
+		for historyPredicates[p.peek().Value] && p.peek().Kind == TokenKeyword {
+			name := p.next().Value
+			val, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			c.Predicates = append(c.Predicates, &Predicate{Name: name, Value: val})
+		}
+	}
+
+	return &c, nil
+}
+
+func (p *parser) parseOperator() (string, error) {
+	tok := p.next()
+
+	if tok.Kind == TokenOperator {
+		return tok.Value, nil
+	}
+
+	switch {
+	case tok.Is("IN"), tok.Is("CHANGED"):
+		return tok.Value, nil
+	case tok.Is("NOT"):
+		if next := p.next(); !next.Is("IN") {
+			return "", p.errorf(next, "expecting IN")
+		}
+		return "NOT IN", nil
+	case tok.Is("IS"):
+		if p.peek().Is("NOT") {
+			p.next()
+			return "IS NOT", nil
+		}
+		return "IS", nil
+	case tok.Is("WAS"):
+		op := "WAS"
+		if p.peek().Is("NOT") {
+			p.next()
+			op += " NOT"
+		}
+		if p.peek().Is("IN") {
+			p.next()
+			op += " IN"
+		}
+		return op, nil
+	}
+
+	return "", p.errorf(tok, "expecting an operator")
+}
+
+func (p *parser) parseValue() (Value, error) {
+	tok := p.next()
+
+	switch {
+	case tok.Kind == TokenString:
+		return &Literal{Value: tok.Value, Quoted: true}, nil
+	case tok.Is("EMPTY"), tok.Is("NULL"):
+		return &Literal{Value: tok.Value}, nil
+	case tok.Kind == TokenLParen:
+		return p.parseList()
+	case tok.Kind == TokenIdent:
+		if p.peek().Kind == TokenLParen {
+			p.next()
+			return p.parseFunction(tok.Value)
+		}
+		return &Literal{Value: tok.Value}, nil
+	}
+
+	return nil, p.errorf(tok, "expecting a value")
+}
+
+func (p *parser) parseList() (Value, error) {
+	var l List
+	for {
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		l.Values = append(l.Values, v)
+
+		tok := p.next()
+		if tok.Kind == TokenRParen {
+			return &l, nil
+		}
+		if tok.Kind != TokenComma {
+			return nil, p.errorf(tok, "expecting , or )")
+		}
+	}
+}
+
+func (p *parser) parseFunction(name string) (Value, error) {
+	fn := Function{Name: name, Args: make([]*Literal, 0)}
+	if p.peek().Kind == TokenRParen {
+		p.next()
+		return &fn, nil
+	}
+
+	for {
+		tok := p.next()
+		switch tok.Kind {
+		case TokenIdent:
+			fn.Args = append(fn.Args, &Literal{Value: tok.Value})
+		case TokenString:
+			fn.Args = append(fn.Args, &Literal{Value: tok.Value, Quoted: true})
+		default:
+			return nil, p.errorf(tok, "expecting a function argument")
+		}
+
+		tok = p.next()
+		if tok.Kind == TokenRParen {
+			return &fn, nil
+		}
+		if tok.Kind != TokenComma {
+			return nil, p.errorf(tok, "expecting , or )")
+		}
+	}
+}
+
+func (p *parser) parseOrderBy() ([]OrderField, error) {
+	var fields []OrderField
+	for {
+		field, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		f := OrderField{Field: field}
+		if tok := p.peek(); tok.Is("ASC") || tok.Is("DESC") {
+			f.Direction = p.next().Value
+		}
+		fields = append(fields, f)
+
+		if p.peek().Kind != TokenComma {
+			return fields, nil
+		}
+		p.next()
+	}
+}
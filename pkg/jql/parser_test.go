@@ -0,0 +1,212 @@
+package jql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	q, err := Parse(`project = TEST AND (status IN (Open, "In Progress") OR assignee = currentUser()) ORDER BY priority DESC, created`)
+	assert.NoError(t, err)
+
+	assert.Equal(t, &Query{
+		Where: &LogicalExpr{
+			Op: OperatorAnd,
+			Exprs: []Expr{
+				&Clause{Field: &Literal{Value: "project"}, Operator: "=", Value: &Literal{Value: "TEST"}},
+				&LogicalExpr{
+					Op: OperatorOr,
+					Exprs: []Expr{
+						&Clause{
+							Field:    &Literal{Value: "status"},
+							Operator: "IN",
+							Value: &List{Values: []Value{
+								&Literal{Value: "Open"},
+								&Literal{Value: "In Progress", Quoted: true},
+							}},
+						},
+						&Clause{
+							Field:    &Literal{Value: "assignee"},
+							Operator: "=",
+							Value:    &Function{Name: "currentUser", Args: []*Literal{}},
+						},
+					},
+				},
+			},
+		},
+		OrderBy: []OrderField{
+			{Field: &Literal{Value: "priority"}, Direction: "DESC"},
+			{Field: &Literal{Value: "created"}},
+		},
+	}, q)
+
+	assert.True(t, q.HasField("Status"))
+	assert.False(t, q.HasField("labels"))
+	assert.Equal(t, []string{"project", "status", "assignee"}, q.Fields())
+}
+
+func TestParseString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "it normalizes spacing and keywords",
+			input:    `project=TEST and status!="In Progress"   order by rank asc`,
+			expected: `project = TEST AND status != "In Progress" ORDER BY rank ASC`,
+		},
+		{
+			name:     "it keeps precedence of and over or",
+			input:    `a = 1 OR b = 2 AND c = 3`,
+			expected: `a = 1 OR (b = 2 AND c = 3)`,
+		},
+		{
+			name:     "it parses symbolic operators",
+			input:    `a = 1 && (b = 2 || c = 3) & !d = 4`,
+			expected: `a = 1 AND (b = 2 OR c = 3) AND NOT d = 4`,
+		},
+		{
+			name:     "it parses negated groups",
+			input:    `not (a = 1 or b = 2)`,
+			expected: `NOT (a = 1 OR b = 2)`,
+		},
+		{
+			name:     "it parses is, not in and empty",
+			input:    `assignee is not empty and labels not in (a, 'b c') and fixVersion is null`,
+			expected: `assignee IS NOT EMPTY AND labels NOT IN (a, "b c") AND fixVersion IS NULL`,
+		},
+		{
+			name:     "it parses functions with arguments",
+			input:    `created >= startOfDay(-1d) AND assignee IN membersOf("jira users")`,
+			expected: `created >= startOfDay(-1d) AND assignee IN membersOf("jira users")`,
+		},
+		{
+			name:     "it parses history operators with predicates",
+			input:    `status was not in (Open, Done) by currentUser() during ("2024/01/01", now()) and priority changed from Low to High`,
+			expected: `status WAS NOT IN (Open, Done) BY currentUser() DURING ("2024/01/01", now()) AND priority CHANGED FROM Low TO High`,
+		},
+		{
+			name:     "it keeps quotes of reserved words and escapes",
+			input:    `summary ~ "empty" AND summary ~ "say \"hi\"" AND "Story Points" > 3 AND cf[10016] > 1`,
+			expected: `summary ~ "empty" AND summary ~ "say \"hi\"" AND "Story Points" > 3 AND cf[10016] > 1`,
+		},
+		{
+			name:     "it parses order by only",
+			input:    `order by created`,
+			expected: `ORDER BY created`,
+		},
+		{
+			name:     "it parses an empty query",
+			input:    ` `,
+			expected: ``,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			q, err := Parse(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, q.String())
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		msg      string
+		expected Position
+	}{
+		{
+			name:     "it reports a missing operator",
+			input:    `project = TEST AND status`,
+			msg:      "expecting an operator but got end of query at character 26",
+			expected: Position{Offset: 25, Length: 1},
+		},
+		{
+			name:     "it reports an unknown operator",
+			input:    `project = TEST ANDD status = Open`,
+			msg:      `expecting AND, OR or ORDER BY but got "ANDD" at character 16`,
+			expected: Position{Offset: 15, Length: 4},
+		},
+		{
+			name:     "it reports a missing parenthesis",
+			input:    `(a = 1 OR b = 2`,
+			msg:      "expecting ) but got end of query at character 16",
+			expected: Position{Offset: 15, Length: 1},
+		},
+		{
+			name:     "it reports a missing quote",
+			input:    `summary ~ "über`,
+			msg:      "missing closing quote \" at character 11",
+			expected: Position{Offset: 10, Length: 5},
+		},
+		{
+			name:     "it reports a reserved word used as a value",
+			input:    `labels = order`,
+			msg:      `expecting a value but got "order" at character 10`,
+			expected: Position{Offset: 9, Length: 5},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tc.input)
+			assert.EqualError(t, err, tc.msg)
+
+			e, ok := err.(*SyntaxError)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, e.Position())
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	out, err := Format(`project = TEST AND (status = Open OR (priority = High AND labels = urgent)) AND NOT (a = 1 OR b = 2) ORDER BY rank`)
+	assert.NoError(t, err)
+	assert.Equal(t, `project = TEST
+AND (
+  status = Open
+  OR (
+    priority = High
+    AND labels = urgent
+  )
+)
+AND NOT (
+  a = 1
+  OR b = 2
+)
+ORDER BY rank`, out)
+
+	_, err = Format(`project =`)
+	assert.Error(t, err)
+}
+
+func TestQuote(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "TEST", Quote("TEST"))
+	assert.Equal(t, `"In Progress"`, Quote("In Progress"))
+	assert.Equal(t, `"empty"`, Quote("empty"))
+	assert.Equal(t, `""`, Quote(""))
+	assert.Equal(t, `"say \"hi\""`, Quote(`say "hi"`))
+}
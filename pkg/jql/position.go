@@ -0,0 +1,122 @@
+package jql
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	linePosition = regexp.MustCompile(`\(line (\d+), character (\d+)\)`)
+	gotToken     = regexp.MustCompile(`got '([^']*)'`)
+	quotedToken  = regexp.MustCompile(`'([^']+)'`)
+)
+
+// Position is a location of a token in the query. Both the offset
+// and the length are in runes.
+type Position struct {
+	Offset int
+	Length int
+}
+
+// Locate finds the token the error message returned by Jira refers to.
+//
+// It understands the `(line 1, character 8)` suffix of the syntax errors
+// and otherwise looks for the first quoted token of the message in the query,
+// eg: the field name in "Field 'foo' does not exist".
+func Locate(query, msg string) (Position, bool) {
+	runes := []rune(query)
+
+	if m := linePosition.FindStringSubmatch(msg); m != nil {
+		line, _ := strconv.Atoi(m[1])
+		char, _ := strconv.Atoi(m[2])
+
+		offset, ok := lineOffset(runes, line, char)
+		if !ok {
+			return Position{}, false
+		}
+
+		length := tokenLength(runes[offset:])
+		if t := gotToken.FindStringSubmatch(msg); t != nil && t[1] != "" && strings.HasPrefix(string(runes[offset:]), t[1]) {
+			length = len([]rune(t[1]))
+		}
+		return Position{Offset: offset, Length: max(length, 1)}, true
+	}
+
+	lower := strings.ToLower(query)
+	for _, m := range quotedToken.FindAllStringSubmatch(msg, -1) {
+		idx := strings.Index(lower, strings.ToLower(m[1]))
+		if idx < 0 {
+			continue
+		}
+		return Position{
+			Offset: len([]rune(query[:idx])),
+			Length: len([]rune(m[1])),
+		}, true
+	}
+
+	return Position{}, false
+}
+
+// Highlight returns the line of the query containing the position
+// followed by a line with carets pointing at the token.
+func Highlight(query string, pos Position) string {
+	runes := []rune(query)
+	if pos.Offset > len(runes) {
+		pos.Offset = len(runes)
+	}
+
+	start := pos.Offset
+	for start > 0 && runes[start-1] != '\n' {
+		start--
+	}
+	end := pos.Offset
+	for end < len(runes) && runes[end] != '\n' {
+		end++
+	}
+
+	length := min(max(pos.Length, 1), max(end-pos.Offset, 1))
+
+	var b strings.Builder
+	b.WriteString(string(runes[start:end]))
+	b.WriteString("\n")
+	for _, r := range runes[start:pos.Offset] {
+		// Keep the tabs, so the carets are aligned in the terminal.
+		if r == '\t' {
+			b.WriteRune('\t')
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	b.WriteString(strings.Repeat("^", length))
+
+	return b.String()
+}
+
+// lineOffset converts the 1-based line and character
+// reported by Jira to an offset in the query.
+func lineOffset(runes []rune, line, char int) (int, bool) {
+	offset := 0
+	for l := 1; l < line; l++ {
+		for offset < len(runes) && runes[offset] != '\n' {
+			offset++
+		}
+		if offset == len(runes) {
+			return 0, false
+		}
+		offset++
+	}
+	offset += char - 1
+	if char < 1 || offset > len(runes) {
+		return 0, false
+	}
+	return offset, true
+}
+
+func tokenLength(runes []rune) int {
+	n := 0
+	for n < len(runes) && !strings.ContainsRune(" \t\n()=!<>~,", runes[n]) {
+		n++
+	}
+	return n
+}
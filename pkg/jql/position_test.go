@@ -0,0 +1,87 @@
+package jql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		query    string
+		msg      string
+		expected Position
+		found    bool
+	}{
+		{
+			name:     "it locates the token from line and character",
+			query:    `project = TEST AND status = Open)`,
+			msg:      "Error in the JQL Query: Expecting 'end of query' but got ')'. (line 1, character 33)",
+			expected: Position{Offset: 32, Length: 1},
+			found:    true,
+		},
+		{
+			name:     "it uses the length of the token that was found",
+			query:    `project = TEST ANDD status = Open`,
+			msg:      "Error in the JQL Query: Expecting either 'OR' or 'AND' but got 'ANDD'. (line 1, character 16)",
+			expected: Position{Offset: 15, Length: 4},
+			found:    true,
+		},
+		{
+			name:     "it locates the token in a multiline query",
+			query:    "project = TEST\nAND foo",
+			msg:      "Error in the JQL Query: Expecting operator before the end of the query. (line 2, character 5)",
+			expected: Position{Offset: 19, Length: 3},
+			found:    true,
+		},
+		{
+			name:     "it locates the quoted token",
+			query:    `project = TEST AND Foo = bar`,
+			msg:      "Field 'foo' does not exist or you do not have permission to view it.",
+			expected: Position{Offset: 19, Length: 3},
+			found:    true,
+		},
+		{
+			name:  "it fails if the token is not in the query",
+			query: `project = TEST`,
+			msg:   "Something went wrong with 'bar'.",
+		},
+		{
+			name:  "it fails if the position is out of the query",
+			query: `project = TEST`,
+			msg:   "Error in the JQL Query: Unexpected. (line 3, character 1)",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pos, ok := Locate(tc.query, tc.msg)
+			assert.Equal(t, tc.found, ok)
+			assert.Equal(t, tc.expected, pos)
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		"project = TEST ANDD status = Open\n               ^^^^",
+		Highlight("project = TEST ANDD status = Open", Position{Offset: 15, Length: 4}),
+	)
+	assert.Equal(t,
+		"AND foo\n    ^^^",
+		Highlight("project = TEST\nAND foo", Position{Offset: 19, Length: 3}),
+	)
+	assert.Equal(t,
+		"project = TEST AND\n                  ^",
+		Highlight("project = TEST AND", Position{Offset: 18, Length: 5}),
+	)
+}
@@ -0,0 +1,187 @@
+// Package keyring stores secrets in the system keyring. It falls back to a
+// file with restricted permissions if the system keyring is not available,
+// for instance, on a headless Linux machine without a secret service.
+package keyring
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/zalando/go-keyring"
+)
+
+// ErrNotFound is returned if the secret doesn't exist.
+var ErrNotFound = errors.New("keyring: secret not found")
+
+// Backend is a keyring storage backend.
+type Backend string
+
+const (
+	// BackendSystem is the keyring provided by the OS.
+	BackendSystem Backend = "system"
+	// BackendFile is a file based keyring.
+	BackendFile Backend = "file"
+)
+
+const (
+	filePerm = 0o600
+	dirPerm  = 0o700
+)
+
+// Keyring is a secret store for a service.
+type Keyring struct {
+	service string
+	file    string
+	backend Backend
+	mu      sync.Mutex
+}
+
+// New creates a new keyring for the service. The file is used as a fallback
+// storage if the system keyring is unavailable. Pass BackendFile as a backend
+// to always use the file, or an empty string to pick automatically.
+func New(service, file string, backend Backend) *Keyring {
+	return &Keyring{
+		service: service,
+		file:    file,
+		backend: backend,
+	}
+}
+
+// Get fetches the secret for the user along with the backend it was found in.
+func (k *Keyring) Get(user string) (string, Backend, error) {
+	if k.backend != BackendFile {
+		secret, err := keyring.Get(k.service, user)
+		if err == nil {
+			return secret, BackendSystem, nil
+		}
+		if k.backend == BackendSystem {
+			return "", "", convertErr(err)
+		}
+	}
+
+	secrets, err := k.readFile()
+	if err != nil {
+		return "", "", err
+	}
+	secret, ok := secrets[user]
+	if !ok {
+		return "", "", ErrNotFound
+	}
+	return secret, BackendFile, nil
+}
+
+// Set stores the secret for the user and returns the backend it was stored in.
+func (k *Keyring) Set(user, secret string) (Backend, error) {
+	if k.backend != BackendFile {
+		err := keyring.Set(k.service, user, secret)
+		if err == nil {
+			return BackendSystem, nil
+		}
+		if k.backend == BackendSystem || k.file == "" {
+			return "", err
+		}
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	secrets, err := k.readFile()
+	if err != nil {
+		return "", err
+	}
+	secrets[user] = secret
+
+	return BackendFile, k.writeFile(secrets)
+}
+
+// Delete removes the secret for the user from all backends.
+func (k *Keyring) Delete(user string) error {
+	var deleted bool
+
+	if k.backend != BackendFile {
+		err := keyring.Delete(k.service, user)
+		if err == nil {
+			deleted = true
+		} else if k.backend == BackendSystem {
+			return convertErr(err)
+		}
+	}
+
+	if k.backend != BackendSystem && k.file != "" {
+		k.mu.Lock()
+		defer k.mu.Unlock()
+
+		secrets, err := k.readFile()
+		if err != nil {
+			return err
+		}
+		if _, ok := secrets[user]; ok {
+			delete(secrets, user)
+			if err := k.writeFile(secrets); err != nil {
+				return err
+			}
+			deleted = true
+		}
+	}
+
+	if !deleted {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (k *Keyring) readFile() (map[string]string, error) {
+	secrets := make(map[string]string)
+	if k.file == "" {
+		return secrets, nil
+	}
+
+	b, err := os.ReadFile(k.file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return secrets, nil
+		}
+		return nil, err
+	}
+
+	store := make(map[string]map[string]string)
+	if err := json.Unmarshal(b, &store); err != nil {
+		return nil, err
+	}
+	if s, ok := store[k.service]; ok {
+		secrets = s
+	}
+	return secrets, nil
+}
+
+func (k *Keyring) writeFile(secrets map[string]string) error {
+	store := make(map[string]map[string]string)
+
+	if b, err := os.ReadFile(k.file); err == nil {
+		_ = json.Unmarshal(b, &store)
+	}
+	if len(secrets) == 0 {
+		delete(store, k.service)
+	} else {
+		store[k.service] = secrets
+	}
+
+	b, err := json.MarshalIndent(store, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(k.file), dirPerm); err != nil {
+		return err
+	}
+	return os.WriteFile(k.file, b, filePerm)
+}
+
+func convertErr(err error) error {
+	if errors.Is(err, keyring.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
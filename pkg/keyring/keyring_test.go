@@ -0,0 +1,82 @@
+package keyring
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalando/go-keyring"
+)
+
+func TestFileBackend(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "credentials.json")
+	kr := New("jira-cli", file, BackendFile)
+
+	// case: secret doesn't exist
+	_, _, err := kr.Get("test@example.com")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// case: store and fetch secret
+	backend, err := kr.Set("test@example.com", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, BackendFile, backend)
+
+	secret, backend, err := kr.Get("test@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", secret)
+	assert.Equal(t, BackendFile, backend)
+
+	info, err := os.Stat(file)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// case: secrets of other services are kept
+	other := New("other", file, BackendFile)
+	_, err = other.Set("test@example.com", "other-secret")
+	assert.NoError(t, err)
+
+	// case: delete secret
+	assert.NoError(t, kr.Delete("test@example.com"))
+	assert.ErrorIs(t, kr.Delete("test@example.com"), ErrNotFound)
+
+	secret, _, err = other.Get("test@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "other-secret", secret)
+}
+
+func TestSystemBackendWithFallback(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "credentials.json")
+	kr := New("jira-cli", file, "")
+
+	// case: system keyring is available
+	keyring.MockInit()
+
+	backend, err := kr.Set("test@example.com", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, BackendSystem, backend)
+
+	secret, backend, err := kr.Get("test@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", secret)
+	assert.Equal(t, BackendSystem, backend)
+
+	assert.NoError(t, kr.Delete("test@example.com"))
+
+	// case: system keyring is unavailable
+	keyring.MockInitWithError(errors.New("no secret service"))
+
+	backend, err = kr.Set("test@example.com", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, BackendFile, backend)
+
+	secret, backend, err = kr.Get("test@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", secret)
+	assert.Equal(t, BackendFile, backend)
+
+	assert.NoError(t, kr.Delete("test@example.com"))
+}
@@ -0,0 +1,88 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const callbackPage = `<!DOCTYPE html>
+<html><head><title>jira-cli</title></head>
+<body><p>%s</p></body></html>`
+
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// Authorize runs the authorization code flow with PKCE. It starts a loopback
+// server to receive the callback, calls open with the consent page URL and
+// waits for the user to grant access or for the context to be done.
+func (c *Config) Authorize(ctx context.Context, open func(string) error) (*Token, error) {
+	verifier, challenge, err := pkce()
+	if err != nil {
+		return nil, err
+	}
+	state, err := randomString(16) //nolint:mnd
+	if err != nil {
+		return nil, err
+	}
+
+	ln, err := net.Listen("tcp", net.JoinHostPort(callbackHost, strconv.Itoa(c.CallbackPort)))
+	if err != nil {
+		return nil, fmt.Errorf("oauth: unable to start callback server: %w", err)
+	}
+	redirectURI := fmt.Sprintf("http://%s%s", ln.Addr().String(), callbackPath)
+
+	results := make(chan callbackResult, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		var res callbackResult
+		switch {
+		case q.Get("state") != state:
+			res.err = fmt.Errorf("oauth: state mismatch in the callback")
+		case q.Get("error") != "":
+			res.err = &Error{Code: q.Get("error"), Description: q.Get("error_description")}
+		case q.Get("code") == "":
+			res.err = fmt.Errorf("oauth: no authorization code in the callback")
+		default:
+			res.code = q.Get("code")
+		}
+
+		msg := "Authorization complete, you can close this window and return to the terminal."
+		if res.err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			msg = "Authorization failed, check the terminal for details."
+		}
+		_, _ = fmt.Fprintf(w, callbackPage, msg)
+
+		select {
+		case results <- res:
+		default:
+		}
+	})
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second} //nolint:mnd
+	go func() { _ = srv.Serve(ln) }()
+	defer func() { _ = srv.Close() }()
+
+	if err := open(c.AuthCodeURL(state, challenge, redirectURI)); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("oauth: authorization not completed: %w", ctx.Err())
+	case res := <-results:
+		if res.err != nil {
+			return nil, res.err
+		}
+		return c.Exchange(ctx, res.code, verifier, redirectURI)
+	}
+}
@@ -0,0 +1,294 @@
+// Package oauth implements the OAuth 2.0 authorization code grant with PKCE
+// (three-legged OAuth) used to access Jira Cloud on behalf of a user.
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultAuthURL is the Atlassian authorization endpoint.
+	DefaultAuthURL = "https://auth.atlassian.com/authorize"
+	// DefaultTokenURL is the Atlassian token endpoint.
+	DefaultTokenURL = "https://auth.atlassian.com/oauth/token"
+	// DefaultAPIURL is the Atlassian API gateway.
+	DefaultAPIURL = "https://api.atlassian.com"
+	// DefaultCallbackPort is the port of the loopback callback server.
+	DefaultCallbackPort = 8976
+
+	// callbackHost is the loopback address the callback server listens on. The IP literal is used in
+	// the redirect URI too, as localhost may resolve to ::1 first and miss the listener.
+	callbackHost   = "127.0.0.1"
+	callbackPath   = "/callback"
+	requestTimeout = 15 * time.Second
+)
+
+// DefaultScopes are the scopes requested if none are configured.
+// The offline_access scope is required to get a refresh token.
+var DefaultScopes = []string{"read:jira-user", "read:jira-work", "write:jira-work", "offline_access"}
+
+// ErrNoRefreshToken is returned if the token cannot be refreshed.
+var ErrNoRefreshToken = errors.New("oauth: no refresh token")
+
+// Config is an OAuth 2.0 client config.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	AuthURL      string
+	TokenURL     string
+	APIURL       string
+	// CallbackPort is the loopback port registered as the callback URL of the app.
+	// A random free port is used if it is 0.
+	CallbackPort int
+	HTTPClient   *http.Client
+}
+
+// Token is an OAuth 2.0 token along with the cloud instance it is granted for.
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+	CloudID      string    `json:"cloud_id,omitempty"`
+}
+
+// Valid checks if the access token is set and not about to expire.
+func (t *Token) Valid() bool {
+	const skew = 30 * time.Second
+
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(skew).Before(t.Expiry)
+}
+
+// Resource is a site the token has access to.
+type Resource struct {
+	ID     string   `json:"id"`
+	URL    string   `json:"url"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// Error is an error response from the authorization server.
+type Error struct {
+	StatusCode  int
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *Error) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("oauth: %s: %s", e.Code, e.Description)
+	}
+	if e.Code != "" {
+		return fmt.Sprintf("oauth: %s", e.Code)
+	}
+	return fmt.Sprintf("oauth: unexpected status code %d", e.StatusCode)
+}
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+// AuthCodeURL returns the URL of the consent page.
+func (c *Config) AuthCodeURL(state, challenge, redirectURI string) string {
+	scopes := c.Scopes
+	if len(scopes) == 0 {
+		scopes = DefaultScopes
+	}
+
+	q := url.Values{}
+	q.Set("audience", "api.atlassian.com")
+	q.Set("client_id", c.ClientID)
+	q.Set("scope", strings.Join(scopes, " "))
+	q.Set("redirect_uri", redirectURI)
+	q.Set("state", state)
+	q.Set("response_type", "code")
+	q.Set("prompt", "consent")
+	q.Set("code_challenge", challenge)
+	q.Set("code_challenge_method", "S256")
+
+	return orDefault(c.AuthURL, DefaultAuthURL) + "?" + q.Encode()
+}
+
+// Exchange exchanges the authorization code for a token.
+func (c *Config) Exchange(ctx context.Context, code, verifier, redirectURI string) (*Token, error) {
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("code", code)
+	v.Set("code_verifier", verifier)
+	v.Set("redirect_uri", redirectURI)
+
+	return c.token(ctx, v)
+}
+
+// Refresh gets a new access token using the refresh token. Refresh tokens are
+// rotated, so the returned token should replace the old one.
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	if refreshToken == "" {
+		return nil, ErrNoRefreshToken
+	}
+
+	v := url.Values{}
+	v.Set("grant_type", "refresh_token")
+	v.Set("refresh_token", refreshToken)
+
+	tok, err := c.token(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	if tok.RefreshToken == "" {
+		tok.RefreshToken = refreshToken
+	}
+	return tok, nil
+}
+
+// Resources returns the sites the access token has access to.
+func (c *Config) Resources(ctx context.Context, accessToken string) ([]Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, orDefault(c.APIURL, DefaultAPIURL)+"/oauth/token/accessible-resources", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	res, err := c.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, parseError(res)
+	}
+
+	var out []Resource
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIBase returns the base URL to access the Jira REST API of the cloud instance.
+func (c *Config) APIBase(cloudID string) string {
+	return fmt.Sprintf("%s/ex/jira/%s", strings.TrimSuffix(orDefault(c.APIURL, DefaultAPIURL), "/"), cloudID)
+}
+
+// FindResource finds the site matching the server URL. If the server is
+// empty and the token has access to a single site, that site is returned.
+func FindResource(resources []Resource, server string) (*Resource, error) {
+	server = strings.TrimSuffix(server, "/")
+
+	if server == "" {
+		if len(resources) == 1 {
+			return &resources[0], nil
+		}
+		return nil, fmt.Errorf("oauth: token has access to %d sites, server must be configured", len(resources))
+	}
+	for i, r := range resources {
+		if strings.EqualFold(strings.TrimSuffix(r.URL, "/"), server) {
+			return &resources[i], nil
+		}
+	}
+	return nil, fmt.Errorf("oauth: token has no access to %s", server)
+}
+
+func (c *Config) token(ctx context.Context, v url.Values) (*Token, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	v.Set("client_id", c.ClientID)
+	if c.ClientSecret != "" {
+		v.Set("client_secret", c.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, orDefault(c.TokenURL, DefaultTokenURL), strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, parseError(res)
+	}
+
+	var out tokenResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	if out.AccessToken == "" {
+		return nil, &Error{StatusCode: res.StatusCode, Code: "invalid_response", Description: "no access token in the response"}
+	}
+
+	tok := Token{AccessToken: out.AccessToken, RefreshToken: out.RefreshToken}
+	if out.ExpiresIn > 0 {
+		tok.Expiry = time.Now().Add(time.Duration(out.ExpiresIn) * time.Second)
+	}
+	return &tok, nil
+}
+
+func (c *Config) client() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func parseError(res *http.Response) error {
+	e := Error{StatusCode: res.StatusCode}
+
+	b, _ := io.ReadAll(res.Body)
+	_ = json.Unmarshal(b, &e)
+
+	return &e
+}
+
+// pkce returns a random code verifier and its S256 challenge.
+func pkce() (string, string, error) {
+	verifier, err := randomString(32) //nolint:mnd
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func orDefault(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
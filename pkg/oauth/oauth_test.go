@@ -0,0 +1,189 @@
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAuthServer is a minimal authorization server that implements
+// the consent redirect, token and accessible resources endpoints.
+type fakeAuthServer struct {
+	t         *testing.T
+	challenge string
+	redirect  string
+	refreshed int
+}
+
+func (f *fakeAuthServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/authorize":
+		q := r.URL.Query()
+		assert.Equal(f.t, "client", q.Get("client_id"))
+		assert.Equal(f.t, "S256", q.Get("code_challenge_method"))
+		assert.Equal(f.t, "read:jira-work offline_access", q.Get("scope"))
+
+		f.challenge = q.Get("code_challenge")
+		f.redirect = q.Get("redirect_uri")
+		assert.True(f.t, strings.HasPrefix(f.redirect, "http://127.0.0.1:"), f.redirect)
+
+		http.Redirect(w, r, f.redirect+"?code=secret-code&state="+url.QueryEscape(q.Get("state")), http.StatusFound)
+	case "/oauth/token":
+		assert.NoError(f.t, r.ParseForm())
+		assert.Equal(f.t, "client", r.PostForm.Get("client_id"))
+
+		var refresh string
+		switch r.PostForm.Get("grant_type") {
+		case "authorization_code":
+			sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+			assert.Equal(f.t, f.challenge, base64.RawURLEncoding.EncodeToString(sum[:]))
+			assert.Equal(f.t, "secret-code", r.PostForm.Get("code"))
+			assert.Equal(f.t, f.redirect, r.PostForm.Get("redirect_uri"))
+			refresh = "refresh-0"
+		case "refresh_token":
+			if r.PostForm.Get("refresh_token") == "revoked" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error": "invalid_grant", "error_description": "Unknown or invalid refresh token."}`))
+				return
+			}
+			f.refreshed++
+			refresh = "refresh-" + string(rune('0'+f.refreshed))
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  "access-" + refresh,
+			"refresh_token": refresh,
+			"expires_in":    3600,
+		})
+	case "/oauth/token/accessible-resources":
+		assert.Equal(f.t, "Bearer access-refresh-0", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`[{"id": "cloud-1", "url": "https://example.atlassian.net", "name": "example"}]`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+type memoryStore struct {
+	tok *Token
+}
+
+func (m *memoryStore) Load() (*Token, error) { return m.tok, nil }
+
+func (m *memoryStore) Save(t *Token) error {
+	m.tok = t
+	return nil
+}
+
+func newTestConfig(t *testing.T) (*Config, *fakeAuthServer) {
+	fake := &fakeAuthServer{t: t}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return &Config{
+		ClientID: "client",
+		Scopes:   []string{"read:jira-work", "offline_access"},
+		AuthURL:  server.URL + "/authorize",
+		TokenURL: server.URL + "/oauth/token",
+		APIURL:   server.URL,
+	}, fake
+}
+
+func TestAuthorize(t *testing.T) {
+	config, _ := newTestConfig(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Simulate the browser by following the redirect to the loopback server.
+	open := func(u string) error {
+		res, err := http.Get(u) //nolint:gosec,noctx
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		return res.Body.Close()
+	}
+
+	tok, err := config.Authorize(ctx, open)
+	assert.NoError(t, err)
+	assert.Equal(t, "access-refresh-0", tok.AccessToken)
+	assert.Equal(t, "refresh-0", tok.RefreshToken)
+	assert.True(t, tok.Valid())
+
+	resources, err := config.Resources(ctx, tok.AccessToken)
+	assert.NoError(t, err)
+
+	res, err := FindResource(resources, "https://example.atlassian.net/")
+	assert.NoError(t, err)
+	assert.Equal(t, "cloud-1", res.ID)
+	assert.Equal(t, config.APIURL+"/ex/jira/cloud-1", config.APIBase(res.ID))
+
+	_, err = FindResource(resources, "https://other.atlassian.net")
+	assert.Error(t, err)
+}
+
+func TestAuthorizeDenied(t *testing.T) {
+	config := &Config{ClientID: "client"}
+
+	open := func(u string) error {
+		parsed, err := url.Parse(u)
+		assert.NoError(t, err)
+
+		q := parsed.Query()
+		cb := q.Get("redirect_uri") + "?error=access_denied&state=" + url.QueryEscape(q.Get("state"))
+
+		res, err := http.Get(cb) //nolint:gosec,noctx
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		return res.Body.Close()
+	}
+
+	_, err := config.Authorize(context.Background(), open)
+	assert.EqualError(t, err, "oauth: access_denied")
+}
+
+func TestTokenSource(t *testing.T) {
+	config, fake := newTestConfig(t)
+
+	store := &memoryStore{tok: &Token{
+		AccessToken:  "expired",
+		RefreshToken: "refresh-0",
+		Expiry:       time.Now().Add(-time.Minute),
+		CloudID:      "cloud-1",
+	}}
+	src := NewTokenSource(config, store)
+
+	tok, err := src.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "access-refresh-1", tok)
+	assert.Equal(t, "refresh-1", store.tok.RefreshToken)
+	assert.Equal(t, "cloud-1", store.tok.CloudID)
+
+	// Valid token is not refreshed.
+	tok, err = src.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "access-refresh-1", tok)
+	assert.Equal(t, 1, fake.refreshed)
+
+	// Forced refresh uses the rotated refresh token.
+	tok, err = src.Refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "access-refresh-2", tok)
+
+	_, err = NewTokenSource(config, &memoryStore{}).Token()
+	assert.ErrorIs(t, err, ErrNotLoggedIn)
+
+	_, err = NewTokenSource(config, &memoryStore{tok: &Token{RefreshToken: "revoked"}}).Token()
+	assert.EqualError(t, err, "oauth: invalid_grant: Unknown or invalid refresh token.")
+}
@@ -0,0 +1,97 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrNotLoggedIn is returned if there is no stored token.
+var ErrNotLoggedIn = errors.New("oauth: not logged in")
+
+// Store persists the token between runs.
+type Store interface {
+	Load() (*Token, error)
+	Save(*Token) error
+}
+
+// TokenSource supplies a valid access token and refreshes it when it expires.
+type TokenSource struct {
+	config *Config
+	store  Store
+
+	mu  sync.Mutex
+	tok *Token
+}
+
+// NewTokenSource creates a token source backed by the store.
+func NewTokenSource(config *Config, store Store) *TokenSource {
+	return &TokenSource{config: config, store: store}
+}
+
+// Current returns the stored token without refreshing it.
+func (s *TokenSource) Current() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.load()
+}
+
+// Token returns a valid access token, refreshing it if it is expired.
+func (s *TokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tok, err := s.load()
+	if err != nil {
+		return "", err
+	}
+	if tok.Valid() {
+		return tok.AccessToken, nil
+	}
+	return s.refresh(tok)
+}
+
+// Refresh refreshes the access token regardless of its expiry,
+// eg: when the server rejects the current token.
+func (s *TokenSource) Refresh() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tok, err := s.load()
+	if err != nil {
+		return "", err
+	}
+	return s.refresh(tok)
+}
+
+func (s *TokenSource) load() (*Token, error) {
+	if s.tok != nil {
+		return s.tok, nil
+	}
+	tok, err := s.store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil {
+		return nil, ErrNotLoggedIn
+	}
+	s.tok = tok
+
+	return tok, nil
+}
+
+func (s *TokenSource) refresh(old *Token) (string, error) {
+	tok, err := s.config.Refresh(context.Background(), old.RefreshToken)
+	if err != nil {
+		return "", err
+	}
+	tok.CloudID = old.CloudID
+
+	if err := s.store.Save(tok); err != nil {
+		return "", err
+	}
+	s.tok = tok
+
+	return tok.AccessToken, nil
+}
@@ -104,3 +104,27 @@ func edit(editorCommand, fn, initialValue string, stdin io.Reader, stdout io.Wri
 	// strip BOM header
 	return string(bytes.TrimPrefix(raw, bom)), nil
 }
+
+// EditFile opens the given file in the configured editor and waits for it to exit.
+func EditFile(editorCommand, path string) error {
+	if editorCommand == "" {
+		editorCommand = defaultEditor
+	}
+	args, err := shellquote.Split(editorCommand)
+	if err != nil {
+		return err
+	}
+	editorExe, env, err := defaultLookPath(args[0])
+	if err != nil {
+		return err
+	}
+	args = append(editorExe, append(args[1:], path)...)
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Env = env
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
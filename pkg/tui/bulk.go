@@ -0,0 +1,279 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// rowMarker replaces the left padding of the first cell of the marked rows.
+const rowMarker = "●"
+
+// BulkRunFunc runs the bulk action on a copy of a single data row and returns
+// the new values of the cells it changed, keyed by the column index. The table
+// applies them on the UI goroutine.
+type BulkRunFunc func(row []string) (map[int]string, error)
+
+// BulkAction is an action that is run on all marked rows at once.
+type BulkAction struct {
+	// Label is the name of the action in the menu.
+	Label string
+	// Prompt, if set, asks the user to type the value of the action, eg: a label.
+	Prompt string
+	// Options, if set, returns the values the user can choose from, eg: the transitions.
+	// It gets a copy of the target rows.
+	Options func(rows [][]string) ([]string, error)
+	// Handler prepares the action for the value chosen by the user
+	// and returns the func that runs it on a single row.
+	Handler func(value string) (BulkRunFunc, error)
+}
+
+// WithBulkActions sets the actions that can be run on the marked rows with 'b'.
+func WithBulkActions(actions ...BulkAction) TableOption {
+	return func(t *Table) {
+		t.bulkActions = actions
+	}
+}
+
+func (t *Table) isMarked(r int) bool {
+	return t.marked[r]
+}
+
+// toggleMark marks or unmarks the selected row and moves to the next one.
+func (t *Table) toggleMark() {
+	r, _ := t.selection()
+	if r < 1 {
+		return
+	}
+	if t.marked[r] {
+		delete(t.marked, r)
+	} else {
+		t.marked[r] = true
+	}
+
+	t.relayout(false)
+	if sel, _ := t.view.GetSelection(); sel+1 < t.view.GetRowCount() {
+		t.view.Select(sel+1, 0)
+	}
+	t.updateFooter()
+}
+
+// toggleMarkAll marks all displayed rows, eg: the rows matching the search,
+// or unmarks them if they are all marked already.
+func (t *Table) toggleMarkAll() {
+	rows := t.displayedRows()
+
+	all := true
+	for _, r := range rows {
+		if !t.marked[r] {
+			all = false
+			break
+		}
+	}
+	for _, r := range rows {
+		if all {
+			delete(t.marked, r)
+		} else {
+			t.marked[r] = true
+		}
+	}
+
+	t.relayout(false)
+	t.updateFooter()
+}
+
+func (t *Table) clearMarks() {
+	clear(t.marked)
+	t.relayout(false)
+	t.updateFooter()
+}
+
+// displayedRows returns the data rows in the order they are displayed.
+func (t *Table) displayedRows() []int {
+	if t.rows != nil {
+		return t.rows
+	}
+	rows := make([]int, 0, len(t.data)-1)
+	for r := 1; r < len(t.data); r++ {
+		rows = append(rows, r)
+	}
+	return rows
+}
+
+// targetRows returns the marked rows in the display order, or the selected row if none is marked.
+func (t *Table) targetRows() []int {
+	if len(t.marked) == 0 {
+		if r, _ := t.selection(); r > 0 {
+			return []int{r}
+		}
+		return nil
+	}
+
+	rows := make([]int, 0, len(t.marked))
+	for _, r := range t.displayedRows() {
+		if t.marked[r] {
+			rows = append(rows, r)
+		}
+	}
+	// Include the marked rows hidden by the filter.
+	for r := range t.marked {
+		if !slices.Contains(rows, r) {
+			rows = append(rows, r)
+		}
+	}
+	return rows
+}
+
+// rowValues returns a copy of the given data rows that is safe to read off the UI goroutine.
+func (t *Table) rowValues(rows []int) [][]string {
+	values := make([][]string, 0, len(rows))
+	for _, r := range rows {
+		values = append(values, slices.Clone(t.data[r]))
+	}
+	return values
+}
+
+// showBulkMenu shows the bulk actions for the marked rows.
+func (t *Table) showBulkMenu() {
+	rows := t.targetRows()
+	if len(t.bulkActions) == 0 || len(rows) == 0 {
+		return
+	}
+
+	labels := make([]string, 0, len(t.bulkActions))
+	for _, a := range t.bulkActions {
+		labels = append(labels, a.Label)
+	}
+
+	// Copy the rows now, as they may be fetched again while the user chooses the action.
+	values := t.rowValues(rows)
+
+	t.showActionModal(fmt.Sprintf("Select the action to run on %s:", plural(len(rows), "issue")), labels, func(i int) {
+		t.chooseBulkValue(t.bulkActions[i], rows, values)
+	})
+}
+
+// chooseBulkValue asks the user for the value of the action and runs it.
+func (t *Table) chooseBulkValue(action BulkAction, rows []int, values [][]string) {
+	switch {
+	case action.Options != nil:
+		go func() {
+			t.screen.QueueUpdateDraw(func() {
+				t.painter.ShowPage("secondary").SendToFront("secondary")
+			})
+			options, err := action.Options(values)
+
+			t.screen.QueueUpdateDraw(func() {
+				t.painter.HidePage("secondary")
+				if err != nil {
+					t.showError(fmt.Sprintf("%s: %s", action.Label, err))
+					return
+				}
+				if len(options) == 0 {
+					t.showError(fmt.Sprintf("%s: no options available", action.Label))
+					return
+				}
+				t.showActionModal(action.Label+":", options, func(i int) {
+					t.runBulk(action, options[i], rows, values)
+				})
+			})
+		}()
+	case action.Prompt != "":
+		t.openPrompt(action.Prompt+": ", "", nil, func(text string, ok bool) {
+			if ok && strings.TrimSpace(text) != "" {
+				t.runBulk(action, strings.TrimSpace(text), rows, values)
+			}
+		})
+	default:
+		t.runBulk(action, "", rows, values)
+	}
+}
+
+// runBulk runs the action on the rows in the background and reports the progress in the footer.
+// The values are the copies of the rows, taken when the action was chosen.
+func (t *Table) runBulk(action BulkAction, value string, rows []int, values [][]string) {
+	title := action.Label
+	if value != "" {
+		title += " " + value
+	}
+	t.inFlight++
+
+	go func() {
+		t.screen.QueueUpdateDraw(func() {
+			t.showStatus(fmt.Sprintf("%s: preparing...", title))
+		})
+
+		run, err := action.Handler(value)
+		if err != nil {
+			t.screen.QueueUpdateDraw(func() {
+				t.inFlight--
+				t.showError(fmt.Sprintf("%s: %s", title, err))
+			})
+			return
+		}
+
+		var failed []string
+		for i, r := range rows {
+			changes, err := run(values[i])
+
+			t.screen.QueueUpdateDraw(func() {
+				if err != nil {
+					failed = append(failed, err.Error())
+				} else if r := t.findRow(r, values[i]); r != -1 {
+					for c, val := range changes {
+						t.data.Update(r, c, val)
+					}
+					delete(t.marked, r)
+					t.forgetDetail(r)
+				}
+				t.relayout(false)
+				t.showStatus(fmt.Sprintf("%s: %d of %d done...", title, i+1, len(rows)))
+			})
+		}
+
+		t.screen.QueueUpdateDraw(func() {
+			t.inFlight--
+			done := len(rows) - len(failed)
+			if len(failed) == 0 {
+				t.showStatus(fmt.Sprintf("%s: updated %s", title, plural(done, "issue")))
+				return
+			}
+			t.showError(fmt.Sprintf(
+				"%s: updated %d of %d, failed: %s", title, done, len(rows), strings.Join(failed, "; "),
+			))
+		})
+	}()
+}
+
+// showActionModal shows the action modal with the given buttons.
+func (t *Table) showActionModal(text string, buttons []string, selected func(i int)) {
+	t.action.ClearButtons().AddButtons(buttons).SetFocus(0)
+	t.action.SetText(text)
+	t.action.GetFooter().SetText("Use TAB or ← → to navigate, ENTER to select, ESC or q to cancel.").SetTextColor(t.theme().Muted)
+	t.action.SetDoneFunc(func(i int, _ string) {
+		t.painter.HidePage("action")
+		if i >= 0 {
+			selected(i)
+		}
+	})
+	t.painter.ShowPage("action")
+}
+
+// showStatus shows the message in the footer until the next update.
+func (t *Table) showStatus(msg string) {
+	t.footer.SetText(pad(msg, 1)).SetTextColor(tcell.ColorDefault)
+}
+
+// showError shows the error in the footer until the next update.
+func (t *Table) showError(msg string) {
+	t.footer.SetText(pad("Error: "+msg, 1)).SetTextColor(t.theme().Error)
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
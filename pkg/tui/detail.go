@@ -0,0 +1,201 @@
+package tui
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	// detailDelay is how long the selection has to stay on a row before its details are fetched.
+	detailDelay    = 150 * time.Millisecond
+	minDetailWidth = 20
+)
+
+// DetailFunc returns the key to cache the details of the row with, a func
+// that fetches the details and a func that renders them in the given width.
+type DetailFunc func(row int) (key string, fetch func() (interface{}, error), render func(data interface{}, width int) (string, error))
+
+// WithDetailFunc sets a func that provides the details of the selected row
+// shown beside the table in the split layout.
+func WithDetailFunc(fn DetailFunc) TableOption {
+	return func(t *Table) {
+		t.detailFunc = fn
+	}
+}
+
+// detailCache caches the fetched details by the row key.
+type detailCache struct {
+	sync.Mutex
+	items map[string]interface{}
+}
+
+func (c *detailCache) get(key string) (interface{}, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	v, ok := c.items[key]
+	return v, ok
+}
+
+func (c *detailCache) set(key string, v interface{}) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.items[key] = v
+}
+
+func (c *detailCache) delete(key string) {
+	c.Lock()
+	defer c.Unlock()
+
+	delete(c.items, key)
+}
+
+func (t *Table) initDetail() {
+	t.detail.
+		SetDynamicColors(true).
+		SetWrap(true).
+		SetWordWrap(true).
+		SetBorder(true).
+		SetBorderColor(t.theme().Border)
+
+	t.view.SetSelectionChangedFunc(func(int, int) {
+		t.scheduleDetail()
+		t.fetchPage()
+	})
+}
+
+// layoutGrid places the table, the details pane, if split, and the footer in the grid.
+func (t *Table) layoutGrid() {
+	split := t.isSplit()
+
+	t.grid.Clear().SetRows(0, 1, 2)
+	if split {
+		t.grid.SetColumns(0, 0).AddItem(t.detail, 0, 1, 1, 1, 0, 0, false)
+	} else {
+		t.grid.SetColumns(0)
+	}
+	t.grid.
+		AddItem(t.view, 0, 0, 1, 1, 0, 0, true).
+		AddItem(t.padding, 1, 0, 1, t.gridCols(), 0, 0, false).
+		AddItem(t.footer, 2, 0, 1, t.gridCols(), 0, 0, false)
+}
+
+func (t *Table) isSplit() bool {
+	return t.split && t.detailFunc != nil
+}
+
+func (t *Table) gridCols() int {
+	if t.isSplit() {
+		return 2
+	}
+	return 1
+}
+
+// toggleSplit shows or hides the details pane.
+func (t *Table) toggleSplit() {
+	if t.detailFunc == nil {
+		return
+	}
+	t.split = !t.split
+	t.layoutGrid()
+	t.scheduleDetail()
+}
+
+// scheduleDetail shows the details of the selected row once the selection settles,
+// so that moving through the rows quickly doesn't trigger a fetch for each of them.
+func (t *Table) scheduleDetail() {
+	if !t.isSplit() {
+		return
+	}
+	if t.detailTimer != nil {
+		t.detailTimer.Stop()
+	}
+
+	r, _ := t.selection()
+	if r < 1 {
+		t.detailKey = ""
+		t.detail.Clear()
+		return
+	}
+
+	key, fetch, render := t.detailFunc(r)
+	t.detailKey = key
+	t.detail.SetTitle(pad(key, 1))
+
+	delay := detailDelay
+	if _, ok := t.details.get(key); ok {
+		delay = 0
+	} else {
+		t.detail.SetText(fmt.Sprintf("Loading %s...", key)).SetTextColor(t.theme().Muted)
+	}
+
+	// The details pane takes half of the grid, minus the borders and the padding.
+	_, _, width, _ := t.grid.GetRect()
+	width = max(width/2-4, minDetailWidth)
+
+	t.detailTimer = time.AfterFunc(delay, func() {
+		t.loadDetail(key, fetch, render, width)
+	})
+}
+
+// loadDetail fetches the details in the background, unless they are cached, and renders them.
+func (t *Table) loadDetail(key string, fetch func() (interface{}, error), render func(interface{}, int) (string, error), width int) {
+	data, ok := t.details.get(key)
+	if !ok {
+		var err error
+		if data, err = fetch(); err != nil {
+			t.showDetailError(key, err)
+			return
+		}
+		t.details.set(key, data)
+	}
+
+	out, err := render(data, width)
+	if err != nil {
+		t.showDetailError(key, err)
+		return
+	}
+
+	t.screen.QueueUpdateDraw(func() {
+		if t.detailKey != key {
+			return
+		}
+		t.detail.SetText(tview.TranslateANSI(out)).SetTextColor(tcell.ColorDefault).ScrollToBeginning()
+	})
+}
+
+func (t *Table) showDetailError(key string, err error) {
+	t.screen.QueueUpdateDraw(func() {
+		if t.detailKey != key {
+			return
+		}
+		t.detail.SetText(fmt.Sprintf("Error: %s", err)).SetTextColor(t.theme().Error)
+	})
+}
+
+// forgetDetail drops the cached details of the row, eg: after the row is updated.
+func (t *Table) forgetDetail(row int) {
+	if t.detailFunc == nil {
+		return
+	}
+	key, _, _ := t.detailFunc(row)
+	t.details.delete(key)
+
+	if key == t.detailKey {
+		t.scheduleDetail()
+	}
+}
+
+// scrollDetail scrolls the details pane by the given number of lines.
+func (t *Table) scrollDetail(lines int) {
+	if !t.isSplit() {
+		return
+	}
+	row, col := t.detail.GetScrollOffset()
+	t.detail.ScrollTo(max(row+lines, 0), col)
+}
@@ -0,0 +1,281 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	keyColumn = "KEY"
+
+	// maxDrillLinks is the number of links shown before the links table scrolls.
+	maxDrillLinks = 8
+)
+
+// DrillLink is an issue related to the issue shown in the drill-down view.
+type DrillLink struct {
+	Relation string // eg: parent, subtask, blocks or epic child.
+	Key      string
+	Summary  string
+	Status   string
+}
+
+// DrillItem is an issue shown in the drill-down view.
+type DrillItem struct {
+	// Text is the issue rendered in the width of the view, it may contain ANSI escape codes.
+	Text  string
+	Links []DrillLink
+}
+
+// DrillFunc fetches the issue with the given key and its related issues
+// and renders it in the given width to show in the drill-down view.
+type DrillFunc func(key string, width int) (*DrillItem, error)
+
+// WithDrillFunc sets a func that is triggered when a user press 'ENTER'
+// to open the selected issue in the drill-down view. The related issues of
+// the issue can then be opened in turn and 'Backspace' goes back.
+func WithDrillFunc(fn DrillFunc) TableOption {
+	return func(t *Table) {
+		t.drillFunc = fn
+	}
+}
+
+// drillEntry is an issue in the back stack of the drill-down view.
+type drillEntry struct {
+	key  string
+	item *DrillItem // Nil while the issue is loading.
+	err  error
+	link int // Selected link, restored when going back to the issue.
+}
+
+// drillView is the drill-down view that shows an issue and its related issues.
+type drillView struct {
+	grid    *tview.Grid
+	body    *tview.TextView
+	links   *tview.Table
+	padding *tview.TextView
+	footer  *tview.TextView
+	stack   []*drillEntry
+}
+
+func (t *Table) initDrill() {
+	theme := t.theme()
+
+	d := &t.drill
+	d.body = tview.NewTextView()
+	d.body.
+		SetDynamicColors(true).
+		SetWrap(true).
+		SetWordWrap(true).
+		SetBorder(true).
+		SetBorderColor(theme.Border)
+
+	d.links = tview.NewTable()
+	d.links.
+		SetSelectable(true, false).
+		SetSelectedStyle(customTUIStyle(t.style)).
+		SetSelectedFunc(func(r, _ int) {
+			t.openLink(r)
+		}).
+		SetInputCapture(t.drillInput).
+		SetBorder(true).
+		SetBorderColor(theme.Border).
+		SetTitle(" Links ")
+
+	d.padding = tview.NewTextView()
+	d.footer = tview.NewTextView()
+	d.footer.SetDynamicColors(true).SetWordWrap(true)
+
+	d.grid = tview.NewGrid().SetColumns(0)
+}
+
+// drillInput handles the keys of the drill-down view.
+func (t *Table) drillInput(ev *tcell.EventKey) *tcell.EventKey {
+	if ev.Key() == tcell.KeyEsc {
+		t.closeDrill()
+		return nil
+	}
+
+	actions := append([]Action{ActionBack, ActionQuit, ActionOpen, ActionDetailDown, ActionDetailUp, ActionCopy, ActionCopyKey}, navigationActions...)
+	action, ok := t.keys().match(ev, actions...)
+	if !ok {
+		return ev
+	}
+	if key, ok := navigationKeys[action]; ok {
+		return tcell.NewEventKey(key, 0, tcell.ModNone)
+	}
+
+	key := t.drillTop().key
+	switch action {
+	case ActionBack:
+		t.popDrill()
+	case ActionQuit:
+		t.closeDrill()
+	case ActionOpen:
+		if t.selectedFunc != nil {
+			t.selectedFunc(0, 0, PreviewData{Key: key})
+		}
+	case ActionDetailDown, ActionDetailUp:
+		lines := 1
+		if action == ActionDetailUp {
+			lines = -1
+		}
+		row, col := t.drill.body.GetScrollOffset()
+		t.drill.body.ScrollTo(max(row+lines, 0), col)
+	case ActionCopy:
+		if t.copyFunc != nil {
+			t.copyFunc(0, 0, PreviewData{Key: key})
+		}
+	case ActionCopyKey:
+		if t.copyKeyFunc != nil {
+			t.copyKeyFunc(0, 0, PreviewData{Key: key})
+		}
+	}
+	return nil
+}
+
+// drillSelected opens the selected issue in the drill-down view.
+func (t *Table) drillSelected() {
+	r, _ := t.selection()
+	if r < 1 {
+		return
+	}
+	key := t.data.Get(r, t.data.GetIndex(keyColumn))
+	if key == "" {
+		return
+	}
+
+	t.drill.stack = nil
+	t.pushDrill(key)
+	t.painter.ShowPage("drill")
+}
+
+// drillTop returns the issue shown in the drill-down view.
+func (t *Table) drillTop() *drillEntry {
+	return t.drill.stack[len(t.drill.stack)-1]
+}
+
+// openLink opens the related issue in the given row of the links table.
+func (t *Table) openLink(r int) {
+	top := t.drillTop()
+	if top.item == nil || r < 0 || r >= len(top.item.Links) {
+		return
+	}
+	top.link = r
+	t.pushDrill(top.item.Links[r].Key)
+}
+
+// pushDrill shows the issue in the drill-down view and fetches it in the background.
+func (t *Table) pushDrill(key string) {
+	entry := &drillEntry{key: key}
+	t.drill.stack = append(t.drill.stack, entry)
+	t.renderDrill()
+
+	// The body takes the whole width of the screen, minus the borders and the padding.
+	_, _, width, _ := t.grid.GetRect()
+	width = max(width-4, minDetailWidth)
+
+	go func() {
+		item, err := t.drillFunc(key, width)
+
+		t.screen.QueueUpdateDraw(func() {
+			entry.item, entry.err = item, err
+			if len(t.drill.stack) > 0 && t.drillTop() == entry {
+				t.renderDrill()
+			}
+		})
+	}()
+}
+
+// popDrill goes back to the previous issue, or to the table from the first one.
+func (t *Table) popDrill() {
+	if len(t.drill.stack) < 2 {
+		t.closeDrill()
+		return
+	}
+	t.drill.stack = t.drill.stack[:len(t.drill.stack)-1]
+	t.renderDrill()
+}
+
+// closeDrill closes the drill-down view and goes back to the table.
+func (t *Table) closeDrill() {
+	t.drill.stack = nil
+	t.painter.HidePage("drill")
+}
+
+// renderDrill renders the issue at the top of the back stack.
+func (t *Table) renderDrill() {
+	var (
+		d     = &t.drill
+		top   = t.drillTop()
+		theme = t.theme()
+	)
+
+	d.body.SetTitle(pad(top.key, 1))
+	d.links.Clear()
+
+	switch {
+	case top.err != nil:
+		d.body.SetText(fmt.Sprintf("Error: %s", top.err)).SetTextColor(theme.Error)
+	case top.item == nil:
+		d.body.SetText(fmt.Sprintf("Loading %s...", top.key)).SetTextColor(theme.Muted)
+	default:
+		d.body.SetText(tview.TranslateANSI(top.item.Text)).SetTextColor(tcell.ColorDefault)
+		t.renderLinks(top.item.Links)
+	}
+	d.body.ScrollToBeginning()
+	d.links.Select(top.link, 0).ScrollToBeginning()
+
+	rows := 1
+	if top.item != nil && len(top.item.Links) > 0 {
+		rows = min(len(top.item.Links), maxDrillLinks)
+	}
+	d.grid.Clear().
+		SetRows(0, rows+2, 1, 2).
+		AddItem(d.body, 0, 0, 1, 1, 0, 0, false).
+		AddItem(d.links, 1, 0, 1, 1, 0, 0, true).
+		AddItem(d.padding, 2, 0, 1, 1, 0, 0, false).
+		AddItem(d.footer, 3, 0, 1, 1, 0, 0, false)
+
+	d.footer.SetText(pad(t.breadcrumb(), 1))
+}
+
+// renderLinks renders the related issues in the links table.
+func (t *Table) renderLinks(links []DrillLink) {
+	theme := t.theme()
+
+	if len(links) == 0 {
+		t.drill.links.SetCell(0, 0, tview.NewTableCell(pad("No related issues", 1)).
+			SetTextColor(theme.Muted).
+			SetSelectable(false))
+		return
+	}
+	for i, l := range links {
+		t.drill.links.
+			SetCell(i, 0, tview.NewTableCell(pad(l.Relation, 1)).SetTextColor(theme.Muted)).
+			SetCell(i, 1, tview.NewTableCell(pad(l.Key, 1)).SetTextColor(theme.Accent)).
+			SetCell(i, 2, tview.NewTableCell(pad(l.Status, 1)).SetTextColor(theme.statusColor(l.Status))).
+			SetCell(i, 3, tview.NewTableCell(pad(l.Summary, 1)).SetExpansion(1))
+	}
+}
+
+// breadcrumb returns the keys of the issues in the back stack and the key hints, eg: TEST-1 › TEST-4.
+func (t *Table) breadcrumb() string {
+	keys := make([]string, 0, len(t.drill.stack))
+	for i, e := range t.drill.stack {
+		if i == len(t.drill.stack)-1 {
+			keys = append(keys, fmt.Sprintf("%s[::b]%s[::-][-]", colorTag(t.theme().Accent), e.key))
+		} else {
+			keys = append(keys, e.key)
+		}
+	}
+
+	hints := fmt.Sprintf("ENTER to open a link, %s to go back, ESC to close", t.keyHint(ActionBack))
+	if t.selectedFunc != nil {
+		hints += fmt.Sprintf(", %s to open in the browser", t.keyHint(ActionOpen))
+	}
+	return strings.Join(keys, " › ") + " · " + hints
+}
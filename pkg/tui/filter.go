@@ -0,0 +1,131 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/shlex"
+)
+
+// Search returns the indexes of the rows that fuzzy match the query.
+//
+// Each space separated term of the query must match a cell of the row,
+// and a term matches a cell if its characters appear in the cell in the
+// same order, eg: "inpg" matches "In Progress". The header row is never
+// returned and an empty query matches all rows.
+func (td TableData) Search(query string) []int {
+	terms := strings.Fields(strings.ToLower(query))
+
+	rows := make([]int, 0, len(td))
+	for r := 1; r < len(td); r++ {
+		if matchRow(td[r], terms, -1, fuzzyMatch) {
+			rows = append(rows, r)
+		}
+	}
+	return rows
+}
+
+// Filter returns the indexes of the rows that match the column filter.
+//
+// The filter is a space separated list of column:value terms, eg:
+// "status:progress assignee:ana". A column matches if the cell contains
+// the value, ignoring the case. Column names can be shortened to a unique
+// prefix, values with spaces can be quoted and the terms without a column
+// match any cell.
+func (td TableData) Filter(query string) ([]int, error) {
+	terms, err := shlex.Split(strings.ToLower(query))
+	if err != nil {
+		terms = strings.Fields(strings.ToLower(query))
+	}
+
+	cols := make([]int, len(terms))
+	for i, term := range terms {
+		cols[i] = -1
+
+		col, val, ok := strings.Cut(term, ":")
+		if !ok || col == "" {
+			continue
+		}
+		c, err := td.columnIndex(col)
+		if err != nil {
+			return nil, err
+		}
+		cols[i], terms[i] = c, val
+	}
+
+	rows := make([]int, 0, len(td))
+	for r := 1; r < len(td); r++ {
+		match := true
+		for i, term := range terms {
+			if !matchRow(td[r], []string{term}, cols[i], strings.Contains) {
+				match = false
+				break
+			}
+		}
+		if match {
+			rows = append(rows, r)
+		}
+	}
+	return rows, nil
+}
+
+// columnIndex finds the column with the given name or a unique prefix of it.
+func (td TableData) columnIndex(name string) (int, error) {
+	if len(td) == 0 {
+		return -1, fmt.Errorf("unknown column %q", name)
+	}
+	if i := td.GetIndex(name); i != -1 {
+		return i, nil
+	}
+
+	found := -1
+	for i, h := range td[0] {
+		if !strings.HasPrefix(strings.ToLower(strings.TrimSpace(h)), name) {
+			continue
+		}
+		if found != -1 {
+			return -1, fmt.Errorf("ambiguous column %q", name)
+		}
+		found = i
+	}
+	if found == -1 {
+		return -1, fmt.Errorf("unknown column %q", name)
+	}
+	return found, nil
+}
+
+// matchRow checks if every term matches a cell of the row, or the cell
+// in the given column if col is not -1. The terms must be in lower case.
+func matchRow(row []string, terms []string, col int, match func(s, term string) bool) bool {
+	for _, term := range terms {
+		found := false
+		for c, cell := range row {
+			if col != -1 && c != col {
+				continue
+			}
+			if match(strings.ToLower(cell), term) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// fuzzyMatch checks if the characters of the term appear in s in the same order.
+func fuzzyMatch(s, term string) bool {
+	i := 0
+	runes := []rune(term)
+	for _, r := range s {
+		if i == len(runes) {
+			break
+		}
+		if r == runes[i] {
+			i++
+		}
+	}
+	return i == len(runes)
+}
@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var filterData = TableData{
+	{"TYPE", "KEY", "SUMMARY", "STATUS", "ASSIGNEE", "ASSIGNED AT"},
+	{"Bug", "TEST-1", "Login fails on Safari", "In Progress", "Ana Silva", ""},
+	{"Story", "TEST-2", "Add dark mode", "To Do", "John Doe", ""},
+	{"Task", "TEST-3", "Update dependencies", "In Progress", "John Doe", ""},
+	{"Bug", "TEST-4", "Crash on logout", "Done", "Ana Silva", ""},
+}
+
+func TestTableDataSearch(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		query    string
+		expected []int
+	}{
+		{name: "empty query matches all rows", query: "", expected: []int{1, 2, 3, 4}},
+		{name: "it ignores the case", query: "login", expected: []int{1}},
+		{name: "it matches the characters in order", query: "inprg", expected: []int{1, 3}},
+		{name: "all terms must match", query: "inprg ana", expected: []int{1}},
+		{name: "header is not matched", query: "summary", expected: []int{}},
+		{name: "no match", query: "xyz", expected: []int{}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, filterData.Search(tc.query))
+		})
+	}
+}
+
+func TestTableDataFilter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		query    string
+		expected []int
+		err      string
+	}{
+		{name: "single column", query: "status:progress", expected: []int{1, 3}},
+		{name: "multiple columns", query: "status:progress assignee:ana", expected: []int{1}},
+		{name: "column prefix", query: "stat:done", expected: []int{4}},
+		{name: "quoted value", query: `status:"to do"`, expected: []int{2}},
+		{name: "term without column", query: "bug", expected: []int{1, 4}},
+		{name: "exact column name wins over prefix", query: "assignee:john", expected: []int{2, 3}},
+		{name: "ambiguous prefix", query: "assign:ana", err: `ambiguous column "assign"`},
+		{name: "unknown column", query: "team:core", err: `unknown column "team"`},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := filterData.Filter(tc.query)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+const (
+	formWidth          = 72
+	formTextAreaHeight = 6
+	minAutocompleteLen = 2
+)
+
+// FormField is a field in the form of a row action.
+type FormField struct {
+	Label string
+	Value string
+	// Options, if set, shows the field as a drop-down to choose the value from.
+	Options []string
+	// Multiline shows the field as a text area, eg: for a comment.
+	Multiline bool
+	// Autocomplete, if set, returns the suggestions for the text typed in the field.
+	// It is run in the background and the suggestions are cached per text.
+	Autocomplete func(text string) ([]string, error)
+}
+
+// FormValues are the values submitted in the form by the field labels.
+type FormValues map[string]string
+
+// RowAction is an action on the selected row that asks the user to fill a form.
+type RowAction struct {
+	// Action is the action that opens the form, it sets the key bound to it.
+	Action Action
+	// Title is the title of the form.
+	Title string
+	// Fields returns the fields of the form for a copy of the data row, eg: with the current values.
+	Fields func(row []string) ([]FormField, error)
+	// Submit runs the action on a copy of the data row with the submitted values and returns
+	// the new values of the cells it changed, keyed by the column index. The table applies
+	// them on the UI goroutine.
+	Submit func(row []string, values FormValues) (map[int]string, error)
+}
+
+// WithRowActions sets the actions that open a form for the selected row.
+func WithRowActions(actions ...RowAction) TableOption {
+	return func(t *Table) {
+		t.rowActions = actions
+	}
+}
+
+// rowAction returns the row action for the action, if any.
+func (t *Table) rowAction(action Action) *RowAction {
+	for i := range t.rowActions {
+		if t.rowActions[i].Action == action {
+			return &t.rowActions[i]
+		}
+	}
+	return nil
+}
+
+// openForm fetches the fields of the action for the selected row and shows the form.
+func (t *Table) openForm(action *RowAction) {
+	r, _ := t.selection()
+	if r < 1 {
+		return
+	}
+	// The fields are fetched and the form is submitted off the UI goroutine, so they get a copy of the row.
+	row := slices.Clone(t.data[r])
+
+	go func() {
+		t.screen.QueueUpdateDraw(func() {
+			t.painter.ShowPage("secondary").SendToFront("secondary")
+		})
+		fields, err := action.Fields(row)
+
+		t.screen.QueueUpdateDraw(func() {
+			t.painter.HidePage("secondary")
+			if err != nil {
+				t.showError(fmt.Sprintf("%s: %s", action.Title, err))
+				return
+			}
+			t.showForm(action, r, row, fields)
+		})
+	}()
+}
+
+// showForm shows the form with the fields and submits it to the action for the data row r.
+func (t *Table) showForm(action *RowAction, r int, row []string, fields []FormField) {
+	var (
+		form   = tview.NewForm()
+		status = tview.NewTextView()
+		height = 6 // Borders, paddings, buttons and the status line.
+	)
+
+	theme := t.theme()
+	form.SetFieldBackgroundColor(theme.Field).
+		SetLabelColor(theme.Accent).
+		SetButtonBackgroundColor(theme.Field)
+
+	for _, f := range fields {
+		switch {
+		case len(f.Options) > 0:
+			current := 0
+			for i, o := range f.Options {
+				if strings.EqualFold(o, f.Value) {
+					current = i
+				}
+			}
+			form.AddDropDown(f.Label, f.Options, current, nil)
+			height += 2
+		case f.Multiline:
+			form.AddTextArea(f.Label, f.Value, 0, formTextAreaHeight, 0, nil)
+			height += formTextAreaHeight + 1
+		default:
+			input := tview.NewInputField().SetLabel(f.Label).SetText(f.Value)
+			if f.Autocomplete != nil {
+				t.autocomplete(input, f.Autocomplete)
+			}
+			form.AddFormItem(input)
+			height += 2
+		}
+	}
+
+	values := func() FormValues {
+		vals := make(FormValues, len(fields))
+		for _, f := range fields {
+			switch item := form.GetFormItemByLabel(f.Label).(type) {
+			case *tview.DropDown:
+				_, vals[f.Label] = item.GetCurrentOption()
+			case *tview.TextArea:
+				vals[f.Label] = strings.TrimSpace(item.GetText())
+			case *tview.InputField:
+				vals[f.Label] = strings.TrimSpace(item.GetText())
+			}
+		}
+		return vals
+	}
+	closeForm := func() {
+		t.painter.RemovePage("form")
+		t.screen.SetFocus(t.view)
+	}
+
+	form.AddButton("Save", func() {
+		vals := values()
+		status.SetText(pad("Saving. Please wait...", 1)).SetTextColor(theme.Muted)
+		t.inFlight++
+
+		go func() {
+			changes, err := action.Submit(row, vals)
+
+			t.screen.QueueUpdateDraw(func() {
+				t.inFlight--
+				if err != nil {
+					status.SetText(pad(fmt.Sprintf("Error: %s", err), 1)).SetTextColor(theme.Error)
+					return
+				}
+				closeForm()
+				if r := t.findRow(r, row); r != -1 {
+					for c, val := range changes {
+						t.data.Update(r, c, val)
+					}
+					t.forgetDetail(r)
+				}
+				t.relayout(false)
+				t.showStatus(fmt.Sprintf("%s: saved", action.Title))
+			})
+		}()
+	})
+	form.AddButton("Cancel", closeForm)
+	form.SetCancelFunc(closeForm)
+
+	status.SetText(pad("Use TAB to navigate and ESC to cancel.", 1)).SetTextColor(theme.Muted)
+
+	frame := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(form, 0, 1, true).
+		AddItem(status, 1, 0, false)
+	frame.SetBorder(true).SetBorderColor(theme.Border).SetTitle(pad(action.Title, 1))
+
+	t.painter.AddPage("form", tview.NewGrid().
+		SetColumns(0, formWidth, 0).
+		SetRows(0, height, 0).
+		AddItem(frame, 1, 1, 1, 1, 0, 0, true), true, true)
+}
+
+// autocomplete shows the suggestions for the text typed in the input field.
+// The suggestions are fetched in the background so that typing is not blocked.
+func (t *Table) autocomplete(input *tview.InputField, fn func(string) ([]string, error)) {
+	var (
+		cache   = make(map[string][]string)
+		pending = make(map[string]bool)
+	)
+
+	input.SetAutocompleteFunc(func(text string) []string {
+		text = strings.TrimSpace(text)
+		if len(text) < minAutocompleteLen {
+			return nil
+		}
+		if entries, ok := cache[text]; ok {
+			return entries
+		}
+		if pending[text] {
+			return nil
+		}
+		pending[text] = true
+
+		go func() {
+			entries, _ := fn(text)
+
+			t.screen.QueueUpdateDraw(func() {
+				delete(pending, text)
+				cache[text] = entries
+				if strings.TrimSpace(input.GetText()) == text {
+					input.Autocomplete()
+				}
+			})
+		}()
+		return nil
+	})
+	input.SetAutocompletedFunc(func(text string, _, source int) bool {
+		if source != tview.AutocompletedNavigate {
+			input.SetText(text)
+		}
+		return source == tview.AutocompletedEnter || source == tview.AutocompletedClick
+	})
+}
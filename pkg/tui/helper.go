@@ -140,13 +140,14 @@ func cmdExists(cmd string) error {
 }
 
 func customTUIStyle(style TableStyle) tcell.Style {
+	theme := style.theme()
 	bg, ok := tcell.ColorNames[style.SelectionBackground]
 	if !ok {
-		bg = tcell.ColorDefault
+		bg = theme.SelectionBackground
 	}
 	fg, ok := tcell.ColorNames[style.SelectionForeground]
 	if !ok {
-		fg = tcell.ColorDarkOliveGreen
+		fg = theme.SelectionForeground
 	}
 	return tcell.StyleDefault.
 		Background(bg).
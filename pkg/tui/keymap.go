@@ -0,0 +1,434 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Action is an action in the interactive UI that can be bound to keys.
+type Action string
+
+// Actions that can be bound to keys.
+const (
+	ActionUp          Action = "up"
+	ActionDown        Action = "down"
+	ActionLeft        Action = "left"
+	ActionRight       Action = "right"
+	ActionTop         Action = "top"
+	ActionBottom      Action = "bottom"
+	ActionPageDown    Action = "page_down"
+	ActionPageUp      Action = "page_up"
+	ActionQuit        Action = "quit"
+	ActionHelp        Action = "help"
+	ActionFocus       Action = "focus"
+	ActionSearch      Action = "search"
+	ActionFilter      Action = "filter"
+	ActionNextMatch   Action = "next_match"
+	ActionPrevMatch   Action = "prev_match"
+	ActionPrevColumn  Action = "prev_column"
+	ActionNextColumn  Action = "next_column"
+	ActionSort        Action = "sort"
+	ActionHideColumn  Action = "hide_column"
+	ActionShowColumns Action = "show_columns"
+	ActionWiden       Action = "widen"
+	ActionNarrow      Action = "narrow"
+	ActionView        Action = "view"
+	ActionOpen        Action = "open"
+	ActionBack        Action = "back"
+	ActionSplit       Action = "split"
+	ActionDetailDown  Action = "detail_down"
+	ActionDetailUp    Action = "detail_up"
+	ActionMove        Action = "move"
+	ActionAssign      Action = "assign"
+	ActionEdit        Action = "edit"
+	ActionLabels      Action = "labels"
+	ActionComment     Action = "comment"
+	ActionWorklog     Action = "worklog"
+	ActionMark        Action = "mark"
+	ActionMarkAll     Action = "mark_all"
+	ActionBulk        Action = "bulk"
+	ActionRefresh     Action = "refresh"
+	ActionCopy        Action = "copy"
+	ActionCopyKey     Action = "copy_key"
+)
+
+// Keymap presets.
+const (
+	KeymapDefault = "default"
+	KeymapVim     = "vim"
+	KeymapEmacs   = "emacs"
+)
+
+// binding describes what an action does in the help page.
+type binding struct {
+	action Action
+	help   string
+}
+
+// bindings lists the actions in the order they are shown in the help page.
+var bindings = []binding{
+	{ActionUp, "to move up"},
+	{ActionDown, "to move down"},
+	{ActionLeft, "to scroll left"},
+	{ActionRight, "to scroll right"},
+	{ActionTop, "to navigate to the top of the list"},
+	{ActionBottom, "to navigate to the bottom of the list"},
+	{ActionPageDown, "to scroll through a page downwards"},
+	{ActionPageUp, "to scroll through a page upwards"},
+	{ActionFocus, "to toggle focus between the sidebar and the contents"},
+	{ActionSearch, "to search the list, eg: [::b]/inprog ana[::-]"},
+	{ActionFilter, "to filter by columns, eg: [::b]status:progress assignee:ana[::-]"},
+	{ActionNextMatch, "to jump to the next match"},
+	{ActionPrevMatch, "to jump to the previous match"},
+	{ActionPrevColumn, "to select the previous column"},
+	{ActionNextColumn, "to select the next column"},
+	{ActionSort, "to sort by the selected column, press again to reverse or reset the order"},
+	{ActionHideColumn, "to hide the selected column"},
+	{ActionShowColumns, "to show the hidden columns"},
+	{ActionWiden, "to widen the selected column"},
+	{ActionNarrow, "to narrow the selected column"},
+	{ActionView, "to view the selected issue details"},
+	{ActionOpen, "to open the issue in the browser"},
+	{ActionBack, "to go back to the previous issue"},
+	{ActionSplit, "to show the selected issue details beside the list"},
+	{ActionDetailDown, "to scroll the details down"},
+	{ActionDetailUp, "to scroll the details up"},
+	{ActionMove, "to move/transition the selected issue"},
+	{ActionAssign, "to assign the selected issue"},
+	{ActionEdit, "to edit the summary and the priority"},
+	{ActionLabels, "to edit the labels"},
+	{ActionComment, "to add a comment"},
+	{ActionWorklog, "to log work"},
+	{ActionMark, "to select the issue"},
+	{ActionMarkAll, "to select all issues matching the search"},
+	{ActionBulk, "to run a bulk action on the selected issues"},
+	{ActionRefresh, "to refresh the list"},
+	{ActionCopy, "to copy the issue URL to the system clipboard"},
+	{ActionCopyKey, "to copy the issue key to the system clipboard"},
+	{ActionHelp, "to view this help page"},
+	{ActionQuit, "to quit the app"},
+}
+
+var defaultKeys = map[Action]string{
+	ActionUp:          "k,up",
+	ActionDown:        "j,down",
+	ActionLeft:        "h,left",
+	ActionRight:       "l,right",
+	ActionTop:         "g,home",
+	ActionBottom:      "G,end",
+	ActionPageDown:    "ctrl+f,pgdn",
+	ActionPageUp:      "ctrl+b,pgup",
+	ActionQuit:        "q",
+	ActionHelp:        "?",
+	ActionFocus:       "w,tab",
+	ActionSearch:      "/",
+	ActionFilter:      "f",
+	ActionNextMatch:   "n",
+	ActionPrevMatch:   "N",
+	ActionPrevColumn:  "<",
+	ActionNextColumn:  ">",
+	ActionSort:        "s",
+	ActionHideColumn:  "H",
+	ActionShowColumns: "U",
+	ActionWiden:       "+",
+	ActionNarrow:      "-",
+	ActionView:        "v",
+	ActionOpen:        "o",
+	ActionBack:        "backspace,ctrl+h",
+	ActionSplit:       "p",
+	ActionDetailDown:  "J",
+	ActionDetailUp:    "K",
+	ActionMove:        "m",
+	ActionAssign:      "a",
+	ActionEdit:        "e",
+	ActionLabels:      "L",
+	ActionComment:     "C",
+	ActionWorklog:     "w",
+	ActionMark:        "space",
+	ActionMarkAll:     "*",
+	ActionBulk:        "b",
+	ActionRefresh:     "ctrl+r,f5",
+	ActionCopy:        "c",
+	ActionCopyKey:     "ctrl+k",
+}
+
+// presets lists the keys that differ from the default ones.
+var presets = map[string]map[Action]string{
+	KeymapDefault: {},
+	KeymapVim: {
+		ActionPageDown:   "ctrl+f,ctrl+d,pgdn",
+		ActionPageUp:     "ctrl+b,ctrl+u,pgup",
+		ActionDetailDown: "J,ctrl+e",
+		ActionDetailUp:   "K,ctrl+y",
+		ActionMark:       "space,x",
+	},
+	KeymapEmacs: {
+		ActionUp:       "ctrl+p,up",
+		ActionDown:     "ctrl+n,down",
+		ActionLeft:     "ctrl+b,left",
+		ActionRight:    "ctrl+f,right",
+		ActionTop:      "alt+<,home",
+		ActionBottom:   "alt+>,end",
+		ActionPageDown: "ctrl+v,pgdn",
+		ActionPageUp:   "alt+v,pgup",
+		ActionSearch:   "ctrl+s,/",
+		ActionQuit:     "q,ctrl+x",
+	},
+}
+
+// Key is a key bound to an action.
+type Key struct {
+	key  tcell.Key
+	r    rune
+	mods tcell.ModMask
+}
+
+var namedKeys = map[string]tcell.Key{
+	"up":        tcell.KeyUp,
+	"down":      tcell.KeyDown,
+	"left":      tcell.KeyLeft,
+	"right":     tcell.KeyRight,
+	"home":      tcell.KeyHome,
+	"end":       tcell.KeyEnd,
+	"pgup":      tcell.KeyPgUp,
+	"pgdn":      tcell.KeyPgDn,
+	"tab":       tcell.KeyTab,
+	"backtab":   tcell.KeyBacktab,
+	"enter":     tcell.KeyEnter,
+	"backspace": tcell.KeyBackspace2,
+	"delete":    tcell.KeyDelete,
+	"insert":    tcell.KeyInsert,
+}
+
+// ParseKey parses a key, eg: j, G, space, ctrl+r, alt+v, f5 or pgdn.
+func ParseKey(s string) (Key, error) {
+	name := strings.TrimSpace(s)
+	if utf8.RuneCountInString(name) == 1 {
+		r, _ := utf8.DecodeRuneInString(name)
+		return Key{key: tcell.KeyRune, r: r}, nil
+	}
+
+	var k Key
+	lower := strings.ToLower(name)
+	for strings.HasPrefix(lower, "alt+") {
+		k.mods |= tcell.ModAlt
+		name, lower = name[4:], lower[4:]
+	}
+	if c, ok := strings.CutPrefix(lower, "ctrl+"); ok {
+		if len(c) != 1 || c[0] < 'a' || c[0] > 'z' {
+			return Key{}, fmt.Errorf("invalid key %q", s)
+		}
+		k.key = tcell.KeyCtrlA + tcell.Key(c[0]-'a')
+		return k, nil
+	}
+
+	switch {
+	case utf8.RuneCountInString(name) == 1:
+		k.key, k.r = tcell.KeyRune, []rune(name)[0]
+	case lower == "space":
+		k.key, k.r = tcell.KeyRune, ' '
+	case len(lower) > 1 && lower[0] == 'f':
+		n, err := strconv.Atoi(lower[1:])
+		if err != nil || n < 1 || n > 12 {
+			return Key{}, fmt.Errorf("invalid key %q", s)
+		}
+		k.key = tcell.KeyF1 + tcell.Key(n-1)
+	default:
+		key, ok := namedKeys[lower]
+		if !ok {
+			return Key{}, fmt.Errorf("invalid key %q", s)
+		}
+		k.key = key
+	}
+	return k, nil
+}
+
+// ParseKeys parses the comma separated list of keys.
+func ParseKeys(s string) ([]Key, error) {
+	var keys []Key
+	for _, name := range splitKeys(s) {
+		k, err := ParseKey(name)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no keys in %q", s)
+	}
+	return keys, nil
+}
+
+// splitKeys splits the list of keys by commas keeping the comma key itself, eg: "<,,,>".
+func splitKeys(s string) []string {
+	var (
+		keys []string
+		cur  strings.Builder
+	)
+	for _, r := range s {
+		if r == ',' && cur.Len() > 0 {
+			keys = append(keys, strings.TrimSpace(cur.String()))
+			cur.Reset()
+			continue
+		}
+		cur.WriteRune(r)
+	}
+	if cur.Len() > 0 {
+		keys = append(keys, strings.TrimSpace(cur.String()))
+	}
+	return keys
+}
+
+// matches checks if the event is fired by the key.
+func (k Key) matches(ev *tcell.EventKey) bool {
+	if ev.Modifiers()&tcell.ModAlt != k.mods&tcell.ModAlt {
+		return false
+	}
+	if k.key == tcell.KeyRune {
+		return ev.Key() == tcell.KeyRune && ev.Rune() == k.r
+	}
+	return ev.Key() == k.key
+}
+
+// String returns the key as displayed in the help page.
+func (k Key) String() string {
+	var s string
+	switch {
+	case k.key == tcell.KeyRune && k.r == ' ':
+		s = "SPACE"
+	case k.key == tcell.KeyRune:
+		s = string(k.r)
+	case k.key >= tcell.KeyCtrlA && k.key <= tcell.KeyCtrlZ:
+		s = fmt.Sprintf("CTRL + %c", 'a'+rune(k.key-tcell.KeyCtrlA))
+	default:
+		s = tcell.KeyNames[k.key]
+		switch k.key {
+		case tcell.KeyUp:
+			s = "↑"
+		case tcell.KeyDown:
+			s = "↓"
+		case tcell.KeyLeft:
+			s = "←"
+		case tcell.KeyRight:
+			s = "→"
+		case tcell.KeyBackspace2:
+			s = "Backspace"
+		}
+	}
+	if k.mods&tcell.ModAlt != 0 {
+		s = "ALT + " + s
+	}
+	return s
+}
+
+// Keymap maps the actions to their keys.
+type Keymap map[Action][]Key
+
+// KeymapPresets returns the names of the keymap presets.
+func KeymapPresets() []string {
+	return []string{KeymapDefault, KeymapVim, KeymapEmacs}
+}
+
+// Actions returns the names of the actions that can be bound to keys.
+func Actions() []string {
+	names := make([]string, 0, len(bindings))
+	for _, b := range bindings {
+		names = append(names, string(b.action))
+	}
+	sort.Strings(names)
+	return names
+}
+
+var defaultKeymap = DefaultKeymap()
+
+// DefaultKeymap returns the default keymap.
+func DefaultKeymap() Keymap {
+	km, _ := NewKeymap(KeymapDefault, nil)
+	return km
+}
+
+// NewKeymap builds the keymap from the preset, eg: vim or emacs,
+// and the keys of the actions overridden by the user.
+func NewKeymap(preset string, overrides map[string]string) (Keymap, error) {
+	if preset == "" {
+		preset = KeymapDefault
+	}
+	keys, ok := presets[preset]
+	if !ok {
+		return nil, fmt.Errorf("unknown keymap %q, expected one of: %s", preset, strings.Join(KeymapPresets(), ", "))
+	}
+
+	km := make(Keymap, len(defaultKeys))
+	for action, def := range defaultKeys {
+		if k, ok := keys[action]; ok {
+			def = k
+		}
+		km[action], _ = ParseKeys(def)
+	}
+
+	for name, val := range overrides {
+		action := Action(strings.ToLower(name))
+		if _, ok := defaultKeys[action]; !ok {
+			return nil, fmt.Errorf("unknown action %q", name)
+		}
+		k, err := ParseKeys(val)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		km[action] = k
+	}
+	return km, nil
+}
+
+// match returns the first of the actions bound to the key of the event.
+func (km Keymap) match(ev *tcell.EventKey, actions ...Action) (Action, bool) {
+	for _, a := range actions {
+		for _, k := range km[a] {
+			if k.matches(ev) {
+				return a, true
+			}
+		}
+	}
+	return "", false
+}
+
+// hint returns the first key of the action.
+func (km Keymap) hint(action Action) string {
+	if len(km[action]) == 0 {
+		return "-"
+	}
+	return km[action][0].String()
+}
+
+// Keys returns the keys of the action as displayed in the help page, eg: j / ↓.
+func (km Keymap) Keys(action Action) string {
+	return km.keys(action)
+}
+
+// keys returns the keys of the action as displayed in the help page.
+func (km Keymap) keys(action Action) string {
+	names := make([]string, 0, len(km[action]))
+	for _, k := range km[action] {
+		names = append(names, k.String())
+	}
+	return strings.Join(names, " / ")
+}
+
+// help generates the help page for the given actions.
+func (km Keymap) help(color tcell.Color, actions ...Action) string {
+	var s strings.Builder
+	for _, b := range bindings {
+		if !slices.Contains(actions, b.action) || len(km[b.action]) == 0 {
+			continue
+		}
+		fmt.Fprintf(&s, "* %s%s[default] %s\n", colorTag(color), tview.Escape(km.keys(b.action)), b.help)
+	}
+	return strings.TrimSuffix(s.String(), "\n")
+}
@@ -0,0 +1,177 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKey(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		expected Key
+		display  string
+		err      string
+	}{
+		{name: "rune", input: "j", expected: Key{key: tcell.KeyRune, r: 'j'}, display: "j"},
+		{name: "it keeps the case", input: "G", expected: Key{key: tcell.KeyRune, r: 'G'}, display: "G"},
+		{name: "space", input: "space", expected: Key{key: tcell.KeyRune, r: ' '}, display: "SPACE"},
+		{name: "ctrl", input: "CTRL+r", expected: Key{key: tcell.KeyCtrlR}, display: "CTRL + r"},
+		{name: "alt", input: "alt+v", expected: Key{key: tcell.KeyRune, r: 'v', mods: tcell.ModAlt}, display: "ALT + v"},
+		{name: "alt symbol", input: "alt+<", expected: Key{key: tcell.KeyRune, r: '<', mods: tcell.ModAlt}, display: "ALT + <"},
+		{name: "function key", input: "f5", expected: Key{key: tcell.KeyF5}, display: "F5"},
+		{name: "named key", input: "pgdn", expected: Key{key: tcell.KeyPgDn}, display: "PgDn"},
+		{name: "arrow", input: "up", expected: Key{key: tcell.KeyUp}, display: "↑"},
+		{name: "invalid ctrl", input: "ctrl+1", err: `invalid key "ctrl+1"`},
+		{name: "invalid function key", input: "f13", err: `invalid key "f13"`},
+		{name: "unknown key", input: "hyper", err: `invalid key "hyper"`},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseKey(tc.input)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.display, got.String())
+		})
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	t.Parallel()
+
+	keys, err := ParseKeys("k, up")
+	assert.NoError(t, err)
+	assert.Equal(t, []Key{{key: tcell.KeyRune, r: 'k'}, {key: tcell.KeyUp}}, keys)
+
+	keys, err = ParseKeys(",,<")
+	assert.NoError(t, err)
+	assert.Equal(t, []Key{{key: tcell.KeyRune, r: ','}, {key: tcell.KeyRune, r: '<'}}, keys)
+
+	_, err = ParseKeys("")
+	assert.EqualError(t, err, `no keys in ""`)
+}
+
+func TestNewKeymap(t *testing.T) {
+	t.Parallel()
+
+	keyEvent := func(r rune) *tcell.EventKey {
+		return tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone)
+	}
+
+	cases := []struct {
+		name      string
+		preset    string
+		overrides map[string]string
+		event     *tcell.EventKey
+		actions   []Action
+		expected  Action
+		matched   bool
+		err       string
+	}{
+		{
+			name:     "default keys",
+			event:    keyEvent('j'),
+			actions:  []Action{ActionUp, ActionDown},
+			expected: ActionDown,
+			matched:  true,
+		},
+		{
+			name:    "it only matches the given actions",
+			event:   keyEvent('j'),
+			actions: []Action{ActionUp},
+		},
+		{
+			name:     "vim preset",
+			preset:   KeymapVim,
+			event:    tcell.NewEventKey(tcell.KeyCtrlD, 0, tcell.ModCtrl),
+			actions:  []Action{ActionPageDown},
+			expected: ActionPageDown,
+			matched:  true,
+		},
+		{
+			name:     "emacs preset",
+			preset:   KeymapEmacs,
+			event:    tcell.NewEventKey(tcell.KeyRune, '>', tcell.ModAlt),
+			actions:  []Action{ActionBottom},
+			expected: ActionBottom,
+			matched:  true,
+		},
+		{
+			name:    "alt key doesn't match the plain key",
+			preset:  KeymapEmacs,
+			event:   keyEvent('>'),
+			actions: []Action{ActionBottom},
+		},
+		{
+			name:      "overridden keys",
+			overrides: map[string]string{"quit": "x"},
+			event:     keyEvent('x'),
+			actions:   []Action{ActionQuit},
+			expected:  ActionQuit,
+			matched:   true,
+		},
+		{
+			name:      "overridden keys replace the default ones",
+			overrides: map[string]string{"quit": "x"},
+			event:     keyEvent('q'),
+			actions:   []Action{ActionQuit},
+		},
+		{
+			name:   "unknown preset",
+			preset: "nano",
+			err:    `unknown keymap "nano", expected one of: default, vim, emacs`,
+		},
+		{
+			name:      "unknown action",
+			overrides: map[string]string{"fly": "x"},
+			err:       `unknown action "fly"`,
+		},
+		{
+			name:      "invalid key",
+			overrides: map[string]string{"quit": "ctrl+"},
+			err:       `quit: invalid key "ctrl+"`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			km, err := NewKeymap(tc.preset, tc.overrides)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			got, ok := km.match(tc.event, tc.actions...)
+			assert.Equal(t, tc.matched, ok)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestKeymapHelp(t *testing.T) {
+	t.Parallel()
+
+	km := DefaultKeymap()
+
+	assert.Equal(t, "k / ↑", km.Keys(ActionUp))
+	assert.Equal(t, "CTRL + r / F5", km.Keys(ActionRefresh))
+	assert.Equal(t, "* [default]k / ↑[default] to move up\n* [default]q[default] to quit the app", km.help(tcell.ColorDefault, ActionQuit, ActionUp))
+}
@@ -0,0 +1,217 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+const (
+	minColWidth  = 3
+	colWidthStep = 4
+)
+
+// SortOrder is the order the rows are sorted in.
+type SortOrder string
+
+// Sort orders.
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// TableLayout is the layout of the table columns chosen by the user.
+// The columns are referred by their lower case header names.
+type TableLayout struct {
+	Sort   string         `mapstructure:"sort" json:"sort,omitempty" yaml:"sort,omitempty"`
+	Order  SortOrder      `mapstructure:"order" json:"order,omitempty" yaml:"order,omitempty"`
+	Hidden []string       `mapstructure:"hidden" json:"hidden,omitempty" yaml:"hidden,omitempty"`
+	Widths map[string]int `mapstructure:"widths" json:"widths,omitempty" yaml:"widths,omitempty"`
+}
+
+// IsZero checks if the layout is the default one.
+func (l TableLayout) IsZero() bool {
+	return l.Sort == "" && len(l.Hidden) == 0 && len(l.Widths) == 0
+}
+
+// LayoutSaveFunc is fired when the table is closed if the user changed its layout.
+type LayoutSaveFunc func(TableLayout) error
+
+// WithTableLayout sets the initial layout of the columns and a func that is
+// triggered to persist the layout when the table is closed, if the user changed it.
+func WithTableLayout(layout TableLayout, save LayoutSaveFunc) TableOption {
+	return func(t *Table) {
+		t.layout = layout
+		t.saveLayout = save
+	}
+}
+
+// arrange computes the rows and the columns to display from the layout and the filter.
+func (t *Table) arrange() {
+	t.cols = t.cols[:0]
+	for c, h := range t.data[0] {
+		if !t.isHidden(h) {
+			t.cols = append(t.cols, c)
+		}
+	}
+	// Never hide all columns, eg: if the columns were renamed since the layout was saved.
+	if len(t.cols) == 0 {
+		for c := range t.data[0] {
+			t.cols = append(t.cols, c)
+		}
+	}
+	if !slices.Contains(t.cols, t.col) {
+		t.col = t.cols[0]
+	}
+
+	sortCol := -1
+	if t.layout.Sort != "" {
+		sortCol = t.data.GetIndex(t.layout.Sort)
+	}
+	if t.matches == nil && sortCol == -1 {
+		t.rows = nil
+		return
+	}
+
+	rows := t.matches
+	if rows == nil {
+		rows = make([]int, 0, len(t.data)-1)
+		for r := 1; r < len(t.data); r++ {
+			rows = append(rows, r)
+		}
+	}
+	if sortCol != -1 {
+		rows = t.data.SortRows(rows, sortCol, t.layout.Order == SortDesc)
+	}
+	t.rows = rows
+}
+
+func (t *Table) isHidden(header string) bool {
+	for _, h := range t.layout.Hidden {
+		if strings.EqualFold(h, header) {
+			return true
+		}
+	}
+	return false
+}
+
+// colName returns the name of the data column used in the layout.
+func (t *Table) colName(c int) string {
+	return strings.ToLower(strings.TrimSpace(t.data[0][c]))
+}
+
+// colWidth returns the max width of the data column.
+func (t *Table) colWidth(c int) int {
+	if w, ok := t.layout.Widths[t.colName(c)]; ok && w > 0 {
+		return w
+	}
+	return int(t.maxColWidth)
+}
+
+// selectColumn moves the column cursor by the given number of visible columns.
+func (t *Table) selectColumn(step int) {
+	i := slices.Index(t.cols, t.col) + step
+	if i < 0 || i >= len(t.cols) {
+		return
+	}
+	t.col = t.cols[i]
+	t.relayout(false)
+}
+
+// sortColumn sorts the rows by the selected column. Pressing it repeatedly
+// toggles between the ascending, the descending and the original order.
+func (t *Table) sortColumn() {
+	name := t.colName(t.col)
+	switch {
+	case t.layout.Sort != name:
+		t.layout.Sort, t.layout.Order = name, SortAsc
+	case t.layout.Order != SortDesc:
+		t.layout.Order = SortDesc
+	default:
+		t.layout.Sort, t.layout.Order = "", ""
+	}
+	t.relayout(true)
+}
+
+// hideColumn hides the selected column unless it is the last one.
+func (t *Table) hideColumn() {
+	if len(t.cols) < 2 {
+		return
+	}
+	i := slices.Index(t.cols, t.col)
+	t.layout.Hidden = append(t.layout.Hidden, t.colName(t.col))
+	if i+1 < len(t.cols) {
+		t.col = t.cols[i+1]
+	} else {
+		t.col = t.cols[i-1]
+	}
+	t.relayout(true)
+}
+
+// showColumns lets the user pick the hidden column to show again.
+func (t *Table) showColumns() {
+	if len(t.layout.Hidden) == 0 {
+		return
+	}
+	buttons := append([]string{"All"}, t.layout.Hidden...)
+
+	t.showActionModal("Select the column to show:", buttons, func(i int) {
+		if i == 0 {
+			t.layout.Hidden = nil
+		} else {
+			t.layout.Hidden = slices.Delete(t.layout.Hidden, i-1, i)
+			t.col = t.data.GetIndex(buttons[i])
+		}
+		t.relayout(true)
+	})
+}
+
+// resizeColumn widens or narrows the selected column.
+func (t *Table) resizeColumn(step int) {
+	// Start from the width the column is displayed with.
+	width := 0
+	for r := 0; r < len(t.data); r++ {
+		width = max(width, tview.TaggedStringWidth(pad(t.data[r][t.col], t.colPad)))
+	}
+	width = min(width, t.colWidth(t.col)) + step
+	if width < minColWidth {
+		width = minColWidth
+	}
+
+	if t.layout.Widths == nil {
+		t.layout.Widths = make(map[string]int)
+	}
+	t.layout.Widths[t.colName(t.col)] = width
+	t.relayout(true)
+}
+
+// relayout renders the table with the current layout keeping the selected row.
+// Set changed if the user changed the layout, so that it is saved when the table is closed.
+func (t *Table) relayout(changed bool) {
+	sel, _ := t.selection()
+
+	t.render(t.data)
+	for i := 1; i < t.view.GetRowCount(); i++ {
+		if t.dataRow(i) == sel {
+			t.view.Select(i, 0)
+			break
+		}
+	}
+
+	if changed {
+		t.layoutEdited = true
+	}
+}
+
+// persistLayout saves the layout once the table is closed, if the user changed it.
+func (t *Table) persistLayout() error {
+	if !t.layoutEdited || t.saveLayout == nil {
+		return nil
+	}
+	if err := t.saveLayout(t.layout); err != nil {
+		return fmt.Errorf("unable to save the layout: %w", err)
+	}
+	return nil
+}
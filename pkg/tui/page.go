@@ -0,0 +1,104 @@
+package tui
+
+import "fmt"
+
+// pageThreshold is how close to the last row the cursor has to get to fetch the next page.
+const pageThreshold = 10
+
+// PageFunc fetches the next page of rows in the background.
+// It returns the rows and whether there are more pages.
+type PageFunc func() (rows TableData, more bool, err error)
+
+// PageLoadedFunc is called with the table data once a page is appended to it.
+// It returns the text to show in the footer, if any, eg: the number of rows loaded.
+type PageLoadedFunc func(data TableData, more bool) string
+
+// PagedTableData is the table data of the contents in the preview
+// layout whose next pages are fetched as the cursor reaches the last rows.
+type PagedTableData struct {
+	Data TableData
+	// Next fetches the next page, it is cleared once there are no more pages.
+	Next PageFunc
+}
+
+// pager keeps the state of the lazy pagination of the table.
+type pager struct {
+	fetch   PageFunc
+	loaded  PageLoadedFunc
+	more    bool
+	loading bool
+	gen     int // Incremented when the table data is replaced, eg: in the preview layout.
+}
+
+// WithPageFunc sets a func that fetches the next page of rows once the
+// cursor reaches the last rows of the table, and a func that is called
+// after the rows are appended.
+func WithPageFunc(fn PageFunc, loaded PageLoadedFunc) TableOption {
+	return func(t *Table) {
+		t.setPager(fn, loaded)
+	}
+}
+
+func (t *Table) setPager(fn PageFunc, loaded PageLoadedFunc) {
+	t.pager = pager{
+		fetch:  fn,
+		loaded: loaded,
+		more:   fn != nil,
+		gen:    t.pager.gen + 1,
+	}
+}
+
+// fetchPage fetches the next page in the background if the cursor is on one of the last rows.
+func (t *Table) fetchPage() {
+	if !t.pager.more || t.pager.loading {
+		return
+	}
+	r, _ := t.view.GetSelection()
+	if r < t.view.GetRowCount()-pageThreshold {
+		return
+	}
+
+	t.pager.loading = true
+	t.showStatus("Loading more...")
+
+	fetch, gen := t.pager.fetch, t.pager.gen
+	go func() {
+		rows, more, err := fetch()
+
+		t.screen.QueueUpdateDraw(func() {
+			if gen != t.pager.gen {
+				return
+			}
+			t.pager.loading = false
+
+			if err != nil {
+				// The next move retries the page.
+				t.showError(fmt.Sprintf("unable to load more: %s", err))
+				return
+			}
+			t.pager.more = more
+			t.appendRows(rows)
+		})
+	}()
+}
+
+// appendRows appends the rows of a page to the table data keeping the search, the filter and the selection.
+func (t *Table) appendRows(rows TableData) {
+	t.data = append(t.data, rows...)
+
+	if t.filtered() {
+		if t.mode == filterColumn {
+			t.matches, _ = t.data.Filter(t.query)
+		} else {
+			t.matches = t.data.Search(t.query)
+		}
+	}
+	if t.pager.loaded != nil {
+		if text := t.pager.loaded(t.data, t.pager.more); text != "" {
+			t.footerText = text
+		}
+	}
+
+	t.relayout(false)
+	t.updateFooter()
+}
@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/gdamore/tcell/v2"
@@ -136,22 +137,48 @@ func (pv *Preview) renderContents(pd PreviewData) {
 	}
 
 	switch v := pv.contentsCache[pd.Key].(type) {
+	case error:
+		// Don't cache the error so that the contents are fetched again on the next visit.
+		delete(pv.contentsCache, pd.Key)
+		pv.printText(fmt.Sprintf("Error: %s", v))
 	case string:
 		pv.printText(v)
 	case TableData:
-		data := pv.contentsCache[pd.Key].(TableData)
-
 		pv.screen.QueueUpdateDraw(func() {
-			pv.contents.view.Clear()
+			pv.renderTable(v, nil)
+		})
+	case *PagedTableData:
+		pv.screen.QueueUpdateDraw(func() {
+			pv.renderTable(v.Data, v)
+		})
+	}
+}
 
-			if len(data) == 1 {
-				pv.printText("No results to show.")
-				return
-			}
+// renderTable renders the table data in the contents screen. If the data
+// is paged, the next pages are fetched as the cursor reaches the last rows.
+func (pv *Preview) renderTable(data TableData, paged *PagedTableData) {
+	pv.contents.view.Clear()
 
-			pv.contents.render(data)
+	if len(data) == 1 {
+		pv.contents.setPager(nil, nil)
+		pv.printText("No results to show.")
+		return
+	}
+
+	if paged != nil {
+		pv.contents.setPager(paged.Next, func(data TableData, more bool) string {
+			paged.Data = data
+			if !more {
+				paged.Next = nil
+			}
+			return ""
 		})
+	} else {
+		pv.contents.setPager(nil, nil)
 	}
+
+	pv.contents.data = data
+	pv.contents.render(data)
 }
 
 func (pv *Preview) init() {
@@ -178,90 +205,89 @@ func (pv *Preview) initSidebar() {
 	pv.sidebar.
 		SetSelectable(true, false).
 		SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
-			if ev.Key() == tcell.KeyTab {
+			action, ok := pv.contents.keys().match(ev, append([]Action{ActionQuit, ActionFocus}, navigationActions...)...)
+			if !ok {
+				return ev
+			}
+			switch action {
+			case ActionQuit:
+				pv.screen.Stop()
+				os.Exit(0)
+			case ActionFocus:
 				pv.screen.SetFocus(pv.contents.view)
 				pv.contents.view.SetSelectable(true, false).Select(1, 0)
+			default:
+				pv.contents.view.SetSelectable(false, false)
+				return tcell.NewEventKey(navigationKeys[action], 0, tcell.ModNone)
 			}
-			if ev.Key() == tcell.KeyRune {
-				switch ev.Rune() {
-				case 'q':
-					pv.screen.Stop()
-					os.Exit(0)
-				case 'w':
-					pv.screen.SetFocus(pv.contents.view)
-					pv.contents.view.SetSelectable(true, false).Select(1, 0)
-				default:
-					pv.contents.view.SetSelectable(false, false)
-				}
-			}
-			return ev
+			return nil
 		})
 }
 
 func (pv *Preview) initContents() {
 	pv.contents.view.
 		SetBorder(true).
-		SetBorderColor(tcell.ColorDarkGray).
+		SetBorderColor(pv.contents.theme().Border).
 		SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
 			contents := func() interface{} {
 				sr, _ := pv.sidebar.GetSelection()
 				return pv.contentsCache[pv.data[sr].Key]
 			}
-			if ev.Key() == tcell.KeyCtrlK {
+			actions := append([]Action{ActionQuit, ActionFocus, ActionCopy, ActionCopyKey, ActionView}, navigationActions...)
+			action, ok := pv.contents.keys().match(ev, actions...)
+			if !ok {
+				return ev
+			}
+			switch action {
+			case ActionQuit:
+				pv.screen.Stop()
+				os.Exit(0)
+			case ActionFocus:
+				pv.screen.SetFocus(pv.sidebar)
+				pv.contents.view.SetSelectable(false, false)
+			case ActionCopyKey:
 				if pv.contents.copyKeyFunc == nil {
-					return ev
+					break
 				}
 				r, c := pv.contents.view.GetSelection()
 				pv.contents.copyKeyFunc(r, c, contents())
-			}
-			if ev.Key() == tcell.KeyTab {
-				pv.screen.SetFocus(pv.sidebar)
-				pv.contents.view.SetSelectable(false, false)
-			}
-			if ev.Key() == tcell.KeyRune {
-				switch ev.Rune() {
-				case 'q':
-					pv.screen.Stop()
-					os.Exit(0)
-				case 'w':
-					pv.screen.SetFocus(pv.sidebar)
-					pv.contents.view.SetSelectable(false, false)
-				case 'c':
-					if pv.contents.copyFunc == nil {
-						break
-					}
-					r, c := pv.contents.view.GetSelection()
-					pv.contents.copyFunc(r, c, contents())
-				case 'v':
-					if pv.contents.viewModeFunc == nil {
-						break
-					}
-					sr, _ := pv.sidebar.GetSelection()
-					r, c := pv.contents.view.GetSelection()
-
-					go func() {
-						func() {
-							pv.painter.ShowPage("secondary")
-							defer func() {
-								pv.painter.HidePage("secondary")
-								pv.screen.SetFocus(pv.contents.view)
-							}()
-
-							contents := pv.contentsCache[pv.data[sr].Key]
-							dataFn, renderFn := pv.contents.viewModeFunc(r, c, contents)
-
-							out, err := renderFn(dataFn())
-							if err == nil {
-								pv.screen.Suspend(func() { _ = PagerOut(out) })
-							}
+			case ActionCopy:
+				if pv.contents.copyFunc == nil {
+					break
+				}
+				r, c := pv.contents.view.GetSelection()
+				pv.contents.copyFunc(r, c, contents())
+			case ActionView:
+				if pv.contents.viewModeFunc == nil {
+					break
+				}
+				sr, _ := pv.sidebar.GetSelection()
+				r, c := pv.contents.view.GetSelection()
+
+				go func() {
+					func() {
+						pv.painter.ShowPage("secondary")
+						defer func() {
+							pv.painter.HidePage("secondary")
+							pv.screen.SetFocus(pv.contents.view)
 						}()
 
-						// Refresh the screen.
-						pv.screen.Draw()
+						contents := pv.contentsCache[pv.data[sr].Key]
+						dataFn, renderFn := pv.contents.viewModeFunc(r, c, contents)
+
+						out, err := renderFn(dataFn())
+						if err == nil {
+							pv.screen.Suspend(func() { _ = PagerOut(out) })
+						}
 					}()
-				}
+
+					// Refresh the screen.
+					pv.screen.Draw()
+				}()
+			default:
+				return tcell.NewEventKey(navigationKeys[action], 0, tcell.ModNone)
 			}
-			return ev
+			return nil
 		})
 }
 
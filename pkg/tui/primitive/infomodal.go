@@ -2,7 +2,6 @@ package primitive
 
 import (
 	"strings"
-	"unicode/utf8"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -69,7 +68,7 @@ func (i *InfoModal) Draw(screen tcell.Screen) {
 	i.info.Clear()
 	lines := strings.Split(i.text, "\n")
 	for _, line := range lines {
-		w := utf8.RuneCountInString(line)
+		w := tview.TaggedStringWidth(line)
 		if w > width {
 			width = w
 		}
@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// valueKind is the type of values in a column used to compare them.
+type valueKind int
+
+const (
+	kindText valueKind = iota
+	kindNumber
+	kindKey
+	kindDate
+	kindPriority
+)
+
+var (
+	issueKeyRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-(\d+)$`)
+
+	dateLayouts = []string{
+		"2006-01-02 15:04:05",
+		"2006-01-02 15:04",
+		"2006-01-02",
+		time.RFC3339,
+		"2006-01-02T15:04:05.000-0700",
+	}
+
+	// priorities ranks the default priorities of Jira Cloud and Jira Server.
+	priorities = map[string]int{
+		"lowest":   1,
+		"trivial":  1,
+		"low":      2,
+		"minor":    2,
+		"medium":   3,
+		"high":     4,
+		"major":    4,
+		"highest":  5,
+		"critical": 5,
+		"blocker":  6,
+	}
+)
+
+// SortRows returns the given rows sorted by the values in the column.
+//
+// The values are compared by their type: issue keys by the project and the
+// number, dates and numbers by their value, priorities by their rank and the
+// rest as case-insensitive text. Empty values are always sorted last, and the
+// rows with the same value keep their order.
+func (td TableData) SortRows(rows []int, col int, desc bool) []int {
+	out := make([]int, len(rows))
+	copy(out, rows)
+
+	if col < 0 || len(td) == 0 || col >= len(td[0]) {
+		return out
+	}
+
+	kind := td.columnKind(rows, col)
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := strings.TrimSpace(td[out[i]][col]), strings.TrimSpace(td[out[j]][col])
+		if a == "" || b == "" {
+			return a != "" && b == ""
+		}
+		if desc {
+			return compareValues(kind, b, a) < 0
+		}
+		return compareValues(kind, a, b) < 0
+	})
+	return out
+}
+
+// columnKind detects the type of the values in the column.
+func (td TableData) columnKind(rows []int, col int) valueKind {
+	kinds := []valueKind{kindNumber, kindKey, kindDate, kindPriority}
+	valid := map[valueKind]bool{kindNumber: true, kindKey: true, kindDate: true, kindPriority: true}
+
+	empty := true
+	for _, r := range rows {
+		v := strings.TrimSpace(td[r][col])
+		if v == "" {
+			continue
+		}
+		empty = false
+
+		for _, k := range kinds {
+			if valid[k] && !isKind(k, v) {
+				valid[k] = false
+			}
+		}
+	}
+	if empty {
+		return kindText
+	}
+	for _, k := range kinds {
+		if valid[k] {
+			return k
+		}
+	}
+	return kindText
+}
+
+func isKind(kind valueKind, v string) bool {
+	switch kind {
+	case kindNumber:
+		_, err := strconv.ParseFloat(v, 64)
+		return err == nil
+	case kindKey:
+		return issueKeyRegex.MatchString(v)
+	case kindDate:
+		_, ok := parseDate(v)
+		return ok
+	case kindPriority:
+		_, ok := priorities[strings.ToLower(v)]
+		return ok
+	}
+	return true
+}
+
+func compareValues(kind valueKind, a, b string) int {
+	switch kind {
+	case kindNumber:
+		x, _ := strconv.ParseFloat(a, 64)
+		y, _ := strconv.ParseFloat(b, 64)
+		return compareFloat(x, y)
+	case kindKey:
+		pa, na := splitKey(a)
+		pb, nb := splitKey(b)
+		if c := strings.Compare(pa, pb); c != 0 {
+			return c
+		}
+		return na - nb
+	case kindDate:
+		x, _ := parseDate(a)
+		y, _ := parseDate(b)
+		return x.Compare(y)
+	case kindPriority:
+		return priorities[strings.ToLower(a)] - priorities[strings.ToLower(b)]
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func compareFloat(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func splitKey(key string) (string, int) {
+	i := strings.LastIndex(key, "-")
+	n, _ := strconv.Atoi(key[i+1:])
+	return strings.ToUpper(key[:i]), n
+}
+
+func parseDate(v string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
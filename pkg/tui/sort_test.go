@@ -0,0 +1,64 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableDataSortRows(t *testing.T) {
+	t.Parallel()
+
+	data := TableData{
+		{"KEY", "PRIORITY", "CREATED", "POINTS", "SUMMARY"},
+		{"TEST-10", "High", "2026-01-02 10:00:00", "3", "beta"},
+		{"TEST-9", "Lowest", "2025-12-31 23:59:59", "13", "Alpha"},
+		{"DEV-100", "Highest", "", "", "gamma"},
+		{"TEST-100", "Medium", "2026-01-02 09:00:00", "0.5", ""},
+	}
+	rows := []int{1, 2, 3, 4}
+
+	cases := []struct {
+		name     string
+		col      int
+		desc     bool
+		expected []int
+	}{
+		{name: "keys by project and number", col: 0, expected: []int{3, 2, 1, 4}},
+		{name: "keys in descending order", col: 0, desc: true, expected: []int{4, 1, 2, 3}},
+		{name: "priorities by rank", col: 1, expected: []int{2, 4, 1, 3}},
+		{name: "priorities in descending order", col: 1, desc: true, expected: []int{3, 1, 4, 2}},
+		{name: "dates with empty values last", col: 2, expected: []int{2, 4, 1, 3}},
+		{name: "dates in descending order with empty values last", col: 2, desc: true, expected: []int{1, 4, 2, 3}},
+		{name: "numbers by value", col: 3, expected: []int{4, 1, 2, 3}},
+		{name: "text ignoring the case", col: 4, expected: []int{2, 1, 3, 4}},
+		{name: "invalid column keeps the order", col: 9, expected: []int{1, 2, 3, 4}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, data.SortRows(rows, tc.col, tc.desc))
+		})
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, rows, "it doesn't modify the given rows")
+}
+
+func TestTableDataSortRowsIsStable(t *testing.T) {
+	t.Parallel()
+
+	data := TableData{
+		{"STATUS"},
+		{"Done"},
+		{"To Do"},
+		{"done"},
+		{"To Do"},
+	}
+
+	assert.Equal(t, []int{1, 3, 2, 4}, data.SortRows([]int{1, 2, 3, 4}, 0, false))
+	assert.Equal(t, []int{2, 4, 1, 3}, data.SortRows([]int{1, 2, 3, 4}, 0, true))
+}
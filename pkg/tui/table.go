@@ -3,7 +3,9 @@ package tui
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -66,31 +68,61 @@ func (td TableData) GetIndex(key string) int {
 	return -1
 }
 
-// Update updates the data at given row and column.
+// Update updates the data at given row and column, if it exists.
 func (td TableData) Update(r, c int, val string) {
-	if r != -1 && c != -1 {
-		td[r][c] = val
+	if r < 0 || r >= len(td) || c < 0 || c >= len(td[r]) {
+		return
 	}
+	td[r][c] = val
 }
 
+// filterMode is the kind of the query used to narrow the rows.
+type filterMode int
+
+const (
+	filterNone filterMode = iota
+	filterSearch
+	filterColumn
+)
+
 // TableStyle sets the style of the table.
 type TableStyle struct {
 	SelectionBackground string
 	SelectionForeground string
 	SelectionTextIsBold bool
+	// Split shows the details of the selected row beside the table.
+	Split bool
+	// Theme sets the colors, the auto theme is used if not set.
+	Theme Theme
+	// Keymap sets the keys of the actions, the default keys are used if not set.
+	Keymap Keymap
 }
 
 // Table is a table layout.
 type Table struct {
 	screen       *Screen
 	painter      *tview.Pages
+	grid         *tview.Grid
 	view         *tview.Table
 	footer       *tview.TextView
+	padding      *tview.TextView
+	prompt       *tview.InputField
+	detail       *tview.TextView
 	secondary    *tview.Modal
 	help         *primitive.InfoModal
 	action       *primitive.ActionModal
 	style        TableStyle
 	data         TableData
+	rows         []int // Data rows displayed in order, nil if the rows are neither filtered nor sorted.
+	cols         []int // Data columns displayed.
+	col          int   // Data column under the column cursor.
+	matches      []int // Data rows matching the query, nil if the rows are not filtered.
+	marked       map[int]bool
+	query        string
+	mode         filterMode
+	layout       TableLayout
+	saveLayout   LayoutSaveFunc
+	layoutEdited bool // Layout changed by the user since the table was painted.
 	colPad       uint
 	colFixed     uint
 	maxColWidth  uint
@@ -102,6 +134,18 @@ type Table struct {
 	refreshFunc  RefreshFunc
 	copyFunc     CopyFunc
 	copyKeyFunc  CopyKeyFunc
+	bulkActions  []BulkAction
+	rowActions   []RowAction
+	detailFunc   DetailFunc
+	details      detailCache
+	detailTimer  *time.Timer
+	detailKey    string // Key of the row shown in the details pane.
+	split        bool
+	pager        pager
+	drillFunc    DrillFunc
+	drill        drillView
+	watch        watcher
+	inFlight     int // Bulk actions and forms being submitted, the watch is paused meanwhile.
 }
 
 // TableOption is a functional option to wrap table properties.
@@ -115,25 +159,32 @@ func NewTable(opts ...TableOption) *Table {
 		screen:      NewScreen(),
 		view:        tview.NewTable(),
 		footer:      tview.NewTextView(),
+		padding:     tview.NewTextView(), // Dummy view to fake row padding.
+		prompt:      tview.NewInputField(),
+		detail:      tview.NewTextView(),
 		help:        primitive.NewInfoModal(),
 		secondary:   getInfoModal(),
 		action:      getActionModal(),
+		marked:      make(map[int]bool),
+		details:     detailCache{items: make(map[string]interface{})},
 		colPad:      defaultColPad,
 		maxColWidth: defaultColWidth,
 	}
 	for _, opt := range opts {
 		opt(&tbl)
 	}
+	tbl.split = tbl.style.Split
+	tbl.action.SetButtonBackgroundColor(tbl.theme().HeaderBackground)
 
 	tbl.initTable()
 	tbl.initFooter()
 	tbl.initHelp()
+	tbl.initPrompt()
+	tbl.initDetail()
+	tbl.initDrill()
 
-	grid := tview.NewGrid().
-		SetRows(0, 1, 2).
-		AddItem(tbl.view, 0, 0, 1, 1, 0, 0, true).
-		AddItem(tview.NewTextView(), 1, 0, 1, 1, 0, 0, false). // Dummy view to fake row padding.
-		AddItem(tbl.footer, 2, 0, 1, 1, 0, 0, false)
+	tbl.grid = tview.NewGrid()
+	tbl.layoutGrid()
 
 	tbl.action.SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
 		if ev.Key() == tcell.KeyEsc || (ev.Key() == tcell.KeyRune && ev.Rune() == 'q') {
@@ -143,10 +194,11 @@ func NewTable(opts ...TableOption) *Table {
 	})
 
 	tbl.painter = tview.NewPages().
-		AddPage("primary", grid, true, true).
+		AddPage("primary", tbl.grid, true, true).
 		AddPage("secondary", tbl.secondary, true, false).
 		AddPage("help", tbl.help, true, false).
-		AddPage("action", tbl.action, true, false)
+		AddPage("action", tbl.action, true, false).
+		AddPage("drill", tbl.drill.grid, true, false)
 
 	return &tbl
 }
@@ -228,19 +280,78 @@ func (t *Table) Paint(data TableData) error {
 	}
 	t.data = data
 	t.render(data)
-	return t.screen.Paint(t.painter)
+
+	done := make(chan struct{})
+	defer close(done)
+	t.startWatch(done)
+
+	if err := t.screen.Paint(t.painter); err != nil {
+		return err
+	}
+	return t.persistLayout()
 }
 
 func (t *Table) render(data TableData) {
-	if t.selectedFunc != nil {
+	switch {
+	case t.drillFunc != nil:
+		t.view.SetSelectedFunc(func(int, int) {
+			t.drillSelected()
+		})
+	case t.selectedFunc != nil:
 		t.view.SetSelectedFunc(func(r, c int) {
-			t.selectedFunc(r, c, data)
+			if r = t.dataRow(r); r > 0 {
+				t.selectedFunc(r, c, data)
+			}
 		})
 	}
+	t.arrange()
+	t.view.Clear()
 	renderTableHeader(t, data[0])
 	renderTableCell(t, data)
 }
 
+// dataRow returns the row in the table data for the given row of the view.
+func (t *Table) dataRow(r int) int {
+	if t.rows == nil {
+		return r
+	}
+	if r < 1 || r > len(t.rows) {
+		return -1
+	}
+	return t.rows[r-1]
+}
+
+// selection returns the data row and the data column of the selected cell.
+func (t *Table) selection() (int, int) {
+	r, c := t.view.GetSelection()
+	if c >= 0 && c < len(t.cols) {
+		c = t.cols[c]
+	}
+	return t.dataRow(r), c
+}
+
+// keys returns the keymap of the table.
+func (t *Table) keys() Keymap {
+	if t.style.Keymap != nil {
+		return t.style.Keymap
+	}
+	return defaultKeymap
+}
+
+// theme returns the color theme of the table.
+func (t *Table) theme() Theme {
+	return t.style.theme()
+}
+
+// theme returns the theme of the style, the auto theme if not set.
+func (s TableStyle) theme() Theme {
+	if s.Theme.Name == "" {
+		theme, _ := LookupTheme(ThemeAuto)
+		return theme
+	}
+	return s.Theme
+}
+
 func (t *Table) initFooter() {
 	t.footer.
 		SetWordWrap(true).
@@ -248,21 +359,183 @@ func (t *Table) initFooter() {
 		SetTextColor(tcell.ColorDefault)
 }
 
+func (t *Table) initPrompt() {
+	t.prompt.
+		SetFieldBackgroundColor(tcell.ColorDefault).
+		SetFieldTextColor(tcell.ColorDefault).
+		SetLabelColor(t.theme().Accent)
+}
+
+// openPrompt shows the prompt in place of the padding row. The changed func,
+// if any, is triggered as the user types, and the done func is triggered with
+// ok set to false if the user cancels the prompt with ESC.
+func (t *Table) openPrompt(label, text string, changed func(string), done func(text string, ok bool)) {
+	t.prompt.SetChangedFunc(nil).SetLabel(label).SetText(text)
+	t.prompt.SetChangedFunc(changed).
+		SetDoneFunc(func(key tcell.Key) {
+			if key != tcell.KeyEnter && key != tcell.KeyEsc {
+				return
+			}
+			t.closePrompt()
+			if done != nil {
+				done(t.prompt.GetText(), key == tcell.KeyEnter)
+			}
+		})
+
+	t.grid.RemoveItem(t.padding).AddItem(t.prompt, 1, 0, 1, t.gridCols(), 0, 0, true)
+	t.screen.SetFocus(t.prompt)
+}
+
+func (t *Table) closePrompt() {
+	t.grid.RemoveItem(t.prompt).AddItem(t.padding, 1, 0, 1, t.gridCols(), 0, 0, false)
+	t.screen.SetFocus(t.view)
+}
+
+// openFilter shows the prompt to search or filter the rows.
+func (t *Table) openFilter(mode filterMode) {
+	label := "/"
+	if mode == filterColumn {
+		label = "Filter (column:value): "
+	}
+	if mode != t.mode {
+		t.clearFilter()
+	}
+	t.mode = mode
+
+	t.openPrompt(label, t.query, func(text string) {
+		t.applyFilter(mode, text)
+	}, func(_ string, ok bool) {
+		if !ok {
+			t.clearFilter()
+		}
+	})
+}
+
+// applyFilter narrows the rows of the table to the ones that match the query.
+func (t *Table) applyFilter(mode filterMode, query string) {
+	if strings.TrimSpace(query) == "" {
+		t.query, t.matches = query, nil
+		t.render(t.data)
+		t.view.Select(1, 0).ScrollToBeginning()
+		t.updateFooter()
+		return
+	}
+
+	var (
+		rows []int
+		err  error
+	)
+	if mode == filterColumn {
+		rows, err = t.data.Filter(query)
+	} else {
+		rows = t.data.Search(query)
+	}
+	if err != nil {
+		t.footer.SetText(pad(fmt.Sprintf("Error: %s", err), 1)).SetTextColor(t.theme().Error)
+		return
+	}
+
+	t.query, t.matches = query, rows
+	t.render(t.data)
+	t.view.Select(1, 0).ScrollToBeginning()
+	t.updateFooter()
+}
+
+// clearFilter restores the full table data.
+func (t *Table) clearFilter() {
+	sel, _ := t.selection()
+
+	t.query, t.matches, t.mode = "", nil, filterNone
+	t.render(t.data)
+	for i := 1; sel > 0 && i < t.view.GetRowCount(); i++ {
+		if t.dataRow(i) == sel {
+			t.view.Select(i, 0)
+			break
+		}
+	}
+	t.updateFooter()
+}
+
+func (t *Table) filtered() bool {
+	return t.matches != nil
+}
+
+// jumpMatch moves the selection to the next or previous matching row.
+func (t *Table) jumpMatch(step int) {
+	n := t.view.GetRowCount() - 1
+	if !t.filtered() || n < 1 {
+		return
+	}
+	r, _ := t.view.GetSelection()
+	r = (r-1+step+n)%n + 1
+	t.view.Select(r, 0)
+}
+
+func (t *Table) updateFooter() {
+	text := t.footerText
+	if t.filtered() {
+		prefix := "/"
+		if t.mode == filterColumn {
+			prefix = "filter: "
+		}
+		text = fmt.Sprintf(
+			"%d of %d matches for %s%s (%s/%s to jump, ESC to clear)",
+			len(t.matches), len(t.data)-1, prefix, tview.Escape(t.query),
+			t.keyHint(ActionNextMatch), t.keyHint(ActionPrevMatch),
+		)
+	}
+	if n := len(t.marked); n > 0 {
+		text += fmt.Sprintf(" · %d selected (%s for bulk actions, ESC to clear)", n, t.keyHint(ActionBulk))
+	}
+	text += t.watchStatus()
+	t.footer.SetText(pad(text, 1)).SetTextColor(tcell.ColorDefault)
+}
+
 func (t *Table) initHelp() {
+	text := t.helpText
+	if text == "" {
+		text = t.generateHelp()
+	}
 	t.help.
-		SetInfo(t.helpText).
+		SetInfo(text).
 		SetAlign(tview.AlignLeft).
 		SetTitle("USAGE")
 
 	t.help.SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
-		if ev.Key() == tcell.KeyEsc || (ev.Key() == tcell.KeyRune && ev.Rune() == 'q') {
+		if _, ok := t.keys().match(ev, ActionQuit, ActionHelp); ok || ev.Key() == tcell.KeyEsc {
 			t.painter.HidePage("help")
 		}
 		return ev
 	})
 }
 
-//nolint:gocyclo
+// generateHelp generates the help page from the keys of the actions available in the table.
+func (t *Table) generateHelp() string {
+	var (
+		s      strings.Builder
+		accent = t.theme().Accent
+	)
+
+	s.WriteString("[default]ACTIONS AVAILABLE IN THE TUI\n----------------------------\n\n")
+	s.WriteString(t.keys().help(accent, t.actions()...))
+	fmt.Fprintf(&s, "\n* %sESC[default] to clear the selection, the search or the filter", colorTag(accent))
+	switch {
+	case t.drillFunc != nil:
+		fmt.Fprintf(
+			&s, "\n* %sENTER[default] to open the selected issue, then its parent, subtasks and linked issues, %s%s[default] to go back",
+			colorTag(accent), colorTag(accent), tview.Escape(t.keys().keys(ActionBack)),
+		)
+	case t.selectedFunc != nil:
+		fmt.Fprintf(&s, "\n* %sENTER[default] to open the selected issue in the browser", colorTag(accent))
+	}
+	return s.String()
+}
+
+// keyHint returns the first key of the action to show in the hints.
+func (t *Table) keyHint(action Action) string {
+	return t.keys().hint(action)
+}
+
 func (t *Table) initTable() {
 	t.view.SetSelectable(true, false).
 		SetSelectedStyle(customTUIStyle(t.style)).
@@ -272,148 +545,308 @@ func (t *Table) initTable() {
 			}
 		}).
 		SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
-			if ev.Key() == tcell.KeyCtrlR || ev.Key() == tcell.KeyF5 {
-				if t.refreshFunc == nil {
-					return ev
-				}
-				t.screen.Stop()
-				t.refreshFunc()
+			if ev.Key() == tcell.KeyEsc && len(t.marked) > 0 {
+				t.clearMarks()
+				return nil
 			}
-			if ev.Key() == tcell.KeyCtrlK {
-				if t.copyKeyFunc == nil {
-					return ev
-				}
-				r, c := t.view.GetSelection()
-				t.copyKeyFunc(r, c, t.data)
+			if ev.Key() == tcell.KeyEsc && t.filtered() {
+				t.clearFilter()
+				return nil
 			}
-			if ev.Key() == tcell.KeyRune {
-				switch ev.Rune() {
-				case 'q':
-					t.screen.Stop()
-					os.Exit(0)
-				case '?':
-					t.painter.ShowPage("help")
-				case 'c':
-					if t.copyFunc == nil {
-						break
-					}
-					r, c := t.view.GetSelection()
-					t.copyFunc(r, c, t.data)
-				case 'v':
-					if t.viewModeFunc == nil {
-						break
-					}
-					r, c := t.view.GetSelection()
-
-					go func() {
-						func() {
-							t.painter.ShowPage("secondary")
-							defer t.painter.HidePage("secondary")
-
-							dataFn, renderFn := t.viewModeFunc(r, c, t.data)
-
-							out, err := renderFn(dataFn())
-							if err == nil {
-								t.screen.Suspend(func() { _ = PagerOut(out) })
-							}
-						}()
-
-						// Refresh the screen.
-						t.screen.Draw()
-					}()
-				case 'm':
-					if t.moveFunc == nil {
-						break
-					}
 
-					refreshContextInFooter := func() {
-						t.action.GetFooter().SetText("Use TAB or ← → to navigate, ENTER to select, ESC or q to cancel.").SetTextColor(tcell.ColorGray)
-					}
-
-					go func() {
-						func() {
-							t.painter.ShowPage("secondary").SendToFront("secondary")
-							defer func() {
-								t.painter.HidePage("secondary")
-								t.painter.ShowPage("action")
-							}()
-							refreshContextInFooter()
-
-							r, c := t.view.GetSelection()
-							key, actions, handler, currentStatus, refreshFunc := t.moveFunc(r, c)()
-
-							currentStatusIdx := func() int {
-								for i, btn := range actions {
-									if btn == currentStatus {
-										return i
-									}
-								}
-								return 0
-							}
-
-							t.action.ClearButtons().AddButtons(actions).SetFocus(currentStatusIdx())
-							t.action.SetText(
-								fmt.Sprintf("Select desired state to transition %s to:", key),
-							)
-
-							t.action.SetDoneFunc(func(btnIndex int, btnLabel string) {
-								t.action.GetFooter().SetText("Processing. Please wait...").SetTextColor(tcell.ColorGray)
-								t.screen.ForceDraw()
-
-								err := handler(btnLabel)
-								if err != nil {
-									t.action.GetFooter().SetText(
-										fmt.Sprintf("Error: %s", err.Error()),
-									).SetTextColor(tcell.ColorRed)
-									return
-								}
-								t.painter.HidePage("action")
-								refreshContextInFooter()
-
-								if refreshFunc != nil {
-									refreshFunc(r, c, btnLabel)
-									_ = t.Paint(t.data)
-								}
-							})
-						}()
-
-						// Refresh the screen.
-						t.screen.Draw()
-					}()
-				}
+			action, ok := t.keys().match(ev, t.actions()...)
+			if !ok {
+				return ev
 			}
-			return ev
+			if key, ok := navigationKeys[action]; ok {
+				return tcell.NewEventKey(key, 0, tcell.ModNone)
+			}
+			t.runAction(action)
+			return nil
 		})
 
 	t.view.SetFixed(1, int(t.colFixed))
 }
 
+// navigationKeys are the keys the table view handles for the navigation actions.
+var navigationKeys = map[Action]tcell.Key{
+	ActionUp:       tcell.KeyUp,
+	ActionDown:     tcell.KeyDown,
+	ActionLeft:     tcell.KeyLeft,
+	ActionRight:    tcell.KeyRight,
+	ActionTop:      tcell.KeyHome,
+	ActionBottom:   tcell.KeyEnd,
+	ActionPageDown: tcell.KeyPgDn,
+	ActionPageUp:   tcell.KeyPgUp,
+}
+
+var navigationActions = []Action{
+	ActionUp, ActionDown, ActionLeft, ActionRight, ActionTop, ActionBottom, ActionPageDown, ActionPageUp,
+}
+
+// actions returns the actions available in the table.
+func (t *Table) actions() []Action {
+	actions := append(slices.Clone(navigationActions),
+		ActionQuit, ActionHelp, ActionSearch, ActionFilter, ActionNextMatch, ActionPrevMatch,
+		ActionPrevColumn, ActionNextColumn, ActionSort, ActionHideColumn, ActionShowColumns, ActionWiden, ActionNarrow,
+	)
+	if t.viewModeFunc != nil {
+		actions = append(actions, ActionView)
+	}
+	if t.drillFunc != nil && t.selectedFunc != nil {
+		actions = append(actions, ActionOpen)
+	}
+	if t.detailFunc != nil {
+		actions = append(actions, ActionSplit, ActionDetailDown, ActionDetailUp)
+	}
+	if t.moveFunc != nil {
+		actions = append(actions, ActionMove)
+	}
+	for _, a := range t.rowActions {
+		actions = append(actions, a.Action)
+	}
+	if len(t.bulkActions) > 0 {
+		actions = append(actions, ActionMark, ActionMarkAll, ActionBulk)
+	}
+	if t.refreshFunc != nil {
+		actions = append(actions, ActionRefresh)
+	}
+	if t.copyFunc != nil {
+		actions = append(actions, ActionCopy)
+	}
+	if t.copyKeyFunc != nil {
+		actions = append(actions, ActionCopyKey)
+	}
+	return actions
+}
+
+//nolint:gocyclo
+func (t *Table) runAction(action Action) {
+	switch action {
+	case ActionQuit:
+		t.screen.Stop()
+		os.Exit(0)
+	case ActionHelp:
+		t.painter.ShowPage("help")
+	case ActionSearch:
+		t.openFilter(filterSearch)
+	case ActionFilter:
+		t.openFilter(filterColumn)
+	case ActionNextMatch:
+		t.jumpMatch(1)
+	case ActionPrevMatch:
+		t.jumpMatch(-1)
+	case ActionMark:
+		t.toggleMark()
+	case ActionMarkAll:
+		t.toggleMarkAll()
+	case ActionBulk:
+		t.showBulkMenu()
+	case ActionSplit:
+		t.toggleSplit()
+	case ActionDetailDown:
+		t.scrollDetail(1)
+	case ActionDetailUp:
+		t.scrollDetail(-1)
+	case ActionPrevColumn:
+		t.selectColumn(-1)
+	case ActionNextColumn:
+		t.selectColumn(1)
+	case ActionSort:
+		t.sortColumn()
+	case ActionHideColumn:
+		t.hideColumn()
+	case ActionShowColumns:
+		t.showColumns()
+	case ActionWiden:
+		t.resizeColumn(colWidthStep)
+	case ActionNarrow:
+		t.resizeColumn(-colWidthStep)
+	case ActionRefresh:
+		t.screen.Stop()
+		t.refreshFunc()
+	case ActionCopy:
+		if r, c := t.selection(); r > 0 {
+			t.copyFunc(r, c, t.data)
+		}
+	case ActionCopyKey:
+		if r, c := t.selection(); r > 0 {
+			t.copyKeyFunc(r, c, t.data)
+		}
+	case ActionView:
+		t.viewSelected()
+	case ActionOpen:
+		if r, c := t.selection(); r > 0 {
+			t.selectedFunc(r, c, t.data)
+		}
+	case ActionMove:
+		t.moveSelected()
+	default:
+		if a := t.rowAction(action); a != nil {
+			t.openForm(a)
+		}
+	}
+}
+
+// viewSelected shows the details of the selected row in the pager.
+func (t *Table) viewSelected() {
+	r, c := t.selection()
+	if r < 1 {
+		return
+	}
+
+	go func() {
+		func() {
+			t.painter.ShowPage("secondary")
+			defer t.painter.HidePage("secondary")
+
+			dataFn, renderFn := t.viewModeFunc(r, c, t.data)
+
+			out, err := renderFn(dataFn())
+			if err == nil {
+				t.screen.Suspend(func() { _ = PagerOut(out) })
+			}
+		}()
+
+		// Refresh the screen.
+		t.screen.Draw()
+	}()
+}
+
+// moveSelected lets the user transition the selected row.
+func (t *Table) moveSelected() {
+	r, c := t.selection()
+	if r < 1 {
+		return
+	}
+
+	refreshContextInFooter := func() {
+		t.action.GetFooter().SetText("Use TAB or ← → to navigate, ENTER to select, ESC or q to cancel.").SetTextColor(t.theme().Muted)
+	}
+
+	go func() {
+		func() {
+			t.painter.ShowPage("secondary").SendToFront("secondary")
+			defer func() {
+				t.painter.HidePage("secondary")
+				t.painter.ShowPage("action")
+			}()
+			refreshContextInFooter()
+
+			key, actions, handler, currentStatus, refreshFunc := t.moveFunc(r, c)()
+
+			currentStatusIdx := func() int {
+				for i, btn := range actions {
+					if btn == currentStatus {
+						return i
+					}
+				}
+				return 0
+			}
+
+			t.action.ClearButtons().AddButtons(actions).SetFocus(currentStatusIdx())
+			t.action.SetText(
+				fmt.Sprintf("Select desired state to transition %s to:", key),
+			)
+
+			t.action.SetDoneFunc(func(btnIndex int, btnLabel string) {
+				t.action.GetFooter().SetText("Processing. Please wait...").SetTextColor(t.theme().Muted)
+				t.screen.ForceDraw()
+
+				err := handler(btnLabel)
+				if err != nil {
+					t.action.GetFooter().SetText(
+						fmt.Sprintf("Error: %s", err.Error()),
+					).SetTextColor(t.theme().Error)
+					return
+				}
+				t.painter.HidePage("action")
+				refreshContextInFooter()
+
+				if refreshFunc != nil {
+					refreshFunc(r, c, btnLabel)
+					t.render(t.data)
+					t.forgetDetail(r)
+				}
+			})
+		}()
+
+		// Refresh the screen.
+		t.screen.Draw()
+	}()
+}
+
 func renderTableHeader(t *Table, data []string) {
-	style := tcell.StyleDefault.Bold(true)
+	style, theme := tcell.StyleDefault.Bold(true), t.theme()
 
-	for c := 0; c < len(data); c++ {
+	for i, c := range t.cols {
 		text := " " + data[c]
+		if strings.EqualFold(t.layout.Sort, strings.TrimSpace(data[c])) {
+			if t.layout.Order == SortDesc {
+				text += " ▼"
+			} else {
+				text += " ▲"
+			}
+		}
+
+		bg := theme.HeaderBackground
+		if c == t.col && len(t.cols) > 1 {
+			bg = theme.HeaderCursor
+		}
 
 		cell := tview.NewTableCell(text).
 			SetStyle(style).
 			SetSelectable(false).
-			SetTextColor(tcell.ColorSnow).
-			SetBackgroundColor(tcell.ColorDarkCyan)
+			SetTextColor(theme.HeaderForeground).
+			SetBackgroundColor(bg)
 
-		t.view.SetCell(0, c, cell)
+		t.view.SetCell(0, i, cell)
 	}
 }
 
 func renderTableCell(t *Table, data TableData) {
-	rows, cols := len(data), len(data[0])
+	rows := t.rows
+	if rows == nil {
+		rows = make([]int, 0, len(data)-1)
+		for r := 1; r < len(data); r++ {
+			rows = append(rows, r)
+		}
+	}
+
+	var (
+		theme    = t.theme()
+		status   = data.GetIndex(statusColumn)
+		priority = data.GetIndex(priorityColumn)
+	)
+
+	for i, r := range rows {
+		marked, changed := t.isMarked(r), t.isChanged(r)
+
+		for j, c := range t.cols {
+			val, color := data.Get(r, c), tcell.ColorDefault
+			switch c {
+			case status:
+				color = theme.statusColor(val)
+			case priority:
+				val = theme.priorityIcon(val)
+			}
 
-	for r := 1; r < rows; r++ {
-		for c := 0; c < cols; c++ {
-			cell := tview.NewTableCell(pad(data.Get(r, c), t.colPad)).
-				SetMaxWidth(int(t.maxColWidth)).
-				SetTextColor(tcell.ColorDefault)
+			text := pad(val, t.colPad)
+			if marked {
+				if j == 0 && strings.HasPrefix(text, " ") {
+					text = rowMarker + text[1:]
+				}
+				color = theme.Accent
+			}
+
+			cell := tview.NewTableCell(text).
+				SetMaxWidth(t.colWidth(c)).
+				SetTextColor(color)
+			if changed && !marked {
+				cell.SetTextColor(theme.Changed).SetAttributes(tcell.AttrBold)
+			}
 
-			t.view.SetCell(r, c, cell)
+			t.view.SetCell(i+1, j, cell)
 		}
 	}
 }
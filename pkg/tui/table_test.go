@@ -0,0 +1,25 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableDataUpdate(t *testing.T) {
+	t.Parallel()
+
+	data := TableData{
+		{"KEY", "STATUS"},
+		{"TEST-1", "To Do"},
+	}
+
+	data.Update(1, 1, "Done")
+	assert.Equal(t, "Done", data[1][1])
+
+	// Out of range cells are ignored, eg: if the list shrank since the action started.
+	for _, rc := range [][2]int{{-1, 1}, {2, 1}, {1, -1}, {1, 2}} {
+		assert.NotPanics(t, func() { data.Update(rc[0], rc[1], "Closed") })
+	}
+	assert.Equal(t, TableData{{"KEY", "STATUS"}, {"TEST-1", "Done"}}, data)
+}
@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/muesli/termenv"
+)
+
+// Theme names.
+const (
+	ThemeAuto  = "auto"
+	ThemeDark  = "dark"
+	ThemeLight = "light"
+	ThemeMono  = "mono"
+)
+
+// Columns colored by the theme.
+const (
+	statusColumn   = "STATUS"
+	priorityColumn = "PRIORITY"
+)
+
+// StatusCategory is the category of an issue status used to color it.
+type StatusCategory int
+
+// Status categories.
+const (
+	StatusToDo StatusCategory = iota
+	StatusInProgress
+	StatusDone
+)
+
+// Theme is a named set of colors used in the interactive UI.
+type Theme struct {
+	Name                string
+	HeaderForeground    tcell.Color
+	HeaderBackground    tcell.Color
+	HeaderCursor        tcell.Color // Background of the header of the selected column.
+	SelectionForeground tcell.Color
+	SelectionBackground tcell.Color
+	Accent              tcell.Color // Keys in the help page, labels and marked rows.
+	Changed             tcell.Color // Rows changed since the previous poll in the watch mode.
+	Muted               tcell.Color // Hints and progress messages.
+	Error               tcell.Color
+	Border              tcell.Color
+	Field               tcell.Color // Background of the form fields and buttons.
+	Statuses            map[StatusCategory]tcell.Color
+	Priorities          map[string]string // Icons shown before the priorities.
+}
+
+var (
+	priorityIcons = map[string]string{
+		"blocker":  "‼",
+		"critical": "‼",
+		"highest":  "⇈",
+		"high":     "↑",
+		"major":    "↑",
+		"medium":   "=",
+		"low":      "↓",
+		"minor":    "↓",
+		"lowest":   "⇊",
+		"trivial":  "⇊",
+	}
+
+	themes = map[string]Theme{
+		ThemeDark: {
+			Name:                ThemeDark,
+			HeaderForeground:    tcell.ColorSnow,
+			HeaderBackground:    tcell.ColorDarkCyan,
+			HeaderCursor:        tcell.ColorTeal,
+			SelectionForeground: tcell.ColorDarkOliveGreen,
+			SelectionBackground: tcell.ColorDefault,
+			Accent:              tcell.ColorYellow,
+			Changed:             tcell.ColorOrange,
+			Muted:               tcell.ColorGray,
+			Error:               tcell.ColorRed,
+			Border:              tcell.ColorDarkSlateGray,
+			Field:               tcell.ColorDarkSlateGray,
+			Statuses: map[StatusCategory]tcell.Color{
+				StatusToDo:       tcell.ColorDefault,
+				StatusInProgress: tcell.ColorDodgerBlue,
+				StatusDone:       tcell.ColorGreen,
+			},
+			Priorities: priorityIcons,
+		},
+		ThemeLight: {
+			Name:                ThemeLight,
+			HeaderForeground:    tcell.ColorWhite,
+			HeaderBackground:    tcell.ColorSteelBlue,
+			HeaderCursor:        tcell.ColorNavy,
+			SelectionForeground: tcell.ColorNavy,
+			SelectionBackground: tcell.ColorLightSteelBlue,
+			Accent:              tcell.ColorDarkOrange,
+			Changed:             tcell.ColorDarkMagenta,
+			Muted:               tcell.ColorDimGray,
+			Error:               tcell.ColorDarkRed,
+			Border:              tcell.ColorDarkGray,
+			Field:               tcell.ColorLightGray,
+			Statuses: map[StatusCategory]tcell.Color{
+				StatusToDo:       tcell.ColorDefault,
+				StatusInProgress: tcell.ColorBlue,
+				StatusDone:       tcell.ColorDarkGreen,
+			},
+			Priorities: priorityIcons,
+		},
+		ThemeMono: {
+			Name:                ThemeMono,
+			HeaderForeground:    tcell.ColorDefault,
+			HeaderBackground:    tcell.ColorDefault,
+			HeaderCursor:        tcell.ColorGray,
+			SelectionForeground: tcell.ColorBlack,
+			SelectionBackground: tcell.ColorWhite,
+			Accent:              tcell.ColorDefault,
+			Changed:             tcell.ColorDefault,
+			Muted:               tcell.ColorDefault,
+			Error:               tcell.ColorDefault,
+			Border:              tcell.ColorDefault,
+			Field:               tcell.ColorGray,
+			Priorities:          priorityIcons,
+		},
+	}
+
+	darkBackground     bool
+	darkBackgroundOnce sync.Once
+)
+
+// Themes returns the names of the themes.
+func Themes() []string {
+	return []string{ThemeAuto, ThemeDark, ThemeLight, ThemeMono}
+}
+
+// LookupTheme returns the theme with the given name. The auto theme
+// picks the dark or the light theme based on the terminal background.
+func LookupTheme(name string) (Theme, error) {
+	if name == "" || name == ThemeAuto {
+		if HasDarkBackground() {
+			return themes[ThemeDark], nil
+		}
+		return themes[ThemeLight], nil
+	}
+	theme, ok := themes[strings.ToLower(name)]
+	if !ok {
+		return Theme{}, fmt.Errorf("unknown theme %q, expected one of: %s", name, strings.Join(Themes(), ", "))
+	}
+	return theme, nil
+}
+
+// HasDarkBackground checks if the terminal has a dark background.
+//
+// Like glamour, it honors the GLAMOUR_STYLE environment variable before
+// asking the terminal, which falls back to the COLORFGBG variable. The
+// terminal must be asked before the interactive UI takes over the screen.
+func HasDarkBackground() bool {
+	darkBackgroundOnce.Do(func() {
+		switch strings.ToLower(os.Getenv("GLAMOUR_STYLE")) {
+		case "light":
+			darkBackground = false
+		case "dark", "dracula", "tokyo-night":
+			darkBackground = true
+		default:
+			darkBackground = IsNotTTY() || termenv.HasDarkBackground()
+		}
+	})
+	return darkBackground
+}
+
+// statusColor returns the color of the status.
+func (th Theme) statusColor(status string) tcell.Color {
+	if c, ok := th.Statuses[statusCategory(status)]; ok {
+		return c
+	}
+	return tcell.ColorDefault
+}
+
+// priorityIcon returns the priority prefixed with its icon.
+func (th Theme) priorityIcon(priority string) string {
+	if icon, ok := th.Priorities[strings.ToLower(strings.TrimSpace(priority))]; ok {
+		return icon + " " + priority
+	}
+	return priority
+}
+
+func statusCategory(status string) StatusCategory {
+	s := strings.ToLower(status)
+	for _, w := range []string{"done", "closed", "resolved", "complete", "fixed", "cancel", "rejected"} {
+		if strings.Contains(s, w) {
+			return StatusDone
+		}
+	}
+	for _, w := range []string{"progress", "review", "test", "doing", "develop", "qa"} {
+		if strings.Contains(s, w) {
+			return StatusInProgress
+		}
+	}
+	return StatusToDo
+}
+
+// colorTag returns the tview color tag of the color.
+func colorTag(c tcell.Color) string {
+	if c == tcell.ColorDefault {
+		return "[default]"
+	}
+	return fmt.Sprintf("[#%06x]", c.Hex())
+}
@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupTheme(t *testing.T) {
+	t.Parallel()
+
+	th, err := LookupTheme("Light")
+	assert.NoError(t, err)
+	assert.Equal(t, ThemeLight, th.Name)
+
+	_, err = LookupTheme("solarized")
+	assert.EqualError(t, err, `unknown theme "solarized", expected one of: auto, dark, light, mono`)
+}
+
+func TestThemeStatusColor(t *testing.T) {
+	t.Parallel()
+
+	th := themes[ThemeDark]
+
+	cases := []struct {
+		status   string
+		expected tcell.Color
+	}{
+		{status: "To Do", expected: tcell.ColorDefault},
+		{status: "Open", expected: tcell.ColorDefault},
+		{status: "In Progress", expected: tcell.ColorDodgerBlue},
+		{status: "In Review", expected: tcell.ColorDodgerBlue},
+		{status: "Done", expected: tcell.ColorGreen},
+		{status: "Closed", expected: tcell.ColorGreen},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.status, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, th.statusColor(tc.status))
+		})
+	}
+
+	assert.Equal(t, tcell.ColorDefault, themes[ThemeMono].statusColor("Done"))
+}
+
+func TestThemePriorityIcon(t *testing.T) {
+	t.Parallel()
+
+	th := themes[ThemeDark]
+
+	assert.Equal(t, "↑ High", th.priorityIcon("High"))
+	assert.Equal(t, "⇊ Lowest", th.priorityIcon("Lowest"))
+	assert.Equal(t, "P1", th.priorityIcon("P1"))
+	assert.Equal(t, "", th.priorityIcon(""))
+}
@@ -0,0 +1,293 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Notifications emitted when the watched rows change.
+const (
+	NotifyBell = "bell"
+	NotifyOSC9 = "osc9"
+)
+
+// watchedColumns are the columns compared to find the rows that changed since the previous poll.
+var watchedColumns = []string{"UPDATED", statusColumn, "ASSIGNEE"}
+
+// WatchFunc fetches the first page of the rows again, along with the header.
+// It returns a func that fetches the next page, if any, and the func to call
+// once the rows are replaced or a page is appended, see WithPageFunc.
+type WatchFunc func() (data TableData, next PageFunc, loaded PageLoadedFunc, err error)
+
+// Notifications returns the notifications that can be emitted in the watch mode.
+func Notifications() []string {
+	return []string{NotifyBell, NotifyOSC9}
+}
+
+// watcher keeps the state of the watch mode of the table.
+type watcher struct {
+	interval time.Duration
+	fetch    WatchFunc
+	notify   string
+	changed  map[int]bool // Data rows changed since the previous poll.
+	polled   time.Time
+	pending  string // Notification written to the terminal once the changed rows are drawn.
+}
+
+// WithWatchFunc sets a func that fetches the rows again on the interval.
+// The rows that are new, or whose status, assignee or update time changed
+// since the previous poll are highlighted, and the notification, if any,
+// is emitted, eg: a terminal bell.
+func WithWatchFunc(interval time.Duration, fn WatchFunc, notify string) TableOption {
+	return func(t *Table) {
+		t.watch = watcher{
+			interval: interval,
+			fetch:    fn,
+			notify:   notify,
+		}
+	}
+}
+
+func (t *Table) watching() bool {
+	return t.watch.fetch != nil && t.watch.interval > 0
+}
+
+// startWatch polls the rows in the background until the done channel is closed, ie: the app stops.
+func (t *Table) startWatch(done <-chan struct{}) {
+	if !t.watching() {
+		return
+	}
+	t.watch.polled = time.Now()
+
+	// The terminal is owned by the screen while the app runs,
+	// so the notification goes through its tty after the draw.
+	t.screen.SetAfterDrawFunc(func(s tcell.Screen) {
+		if t.watch.pending == "" {
+			return
+		}
+		if tty, ok := s.Tty(); ok {
+			_, _ = io.WriteString(tty, t.watch.pending)
+		}
+		t.watch.pending = ""
+	})
+
+	go func() {
+		ticker := time.NewTicker(t.watch.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			data, next, loaded, err := t.watch.fetch()
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			t.screen.QueueUpdateDraw(func() {
+				if err != nil {
+					t.showError(fmt.Sprintf("unable to refresh: %s", err))
+					return
+				}
+				t.replaceRows(data, next, loaded)
+			})
+		}
+	}()
+}
+
+// watchPaused checks if the rows should be kept as is, eg: while a form is open, rows are marked
+// or an action is in flight, as the rows are referenced by their position in the table data.
+func (t *Table) watchPaused() bool {
+	if len(t.marked) > 0 || t.inFlight > 0 {
+		return true
+	}
+	name, _ := t.painter.GetFrontPage()
+	return name != "primary" && name != "drill"
+}
+
+// replaceRows replaces the table data with the rows fetched again keeping the search, the filter and the selection.
+func (t *Table) replaceRows(data TableData, next PageFunc, loaded PageLoadedFunc) {
+	if len(data) == 0 || t.watchPaused() {
+		return
+	}
+
+	sel, _ := t.selection()
+	key := t.rowKey(t.data, sel)
+
+	changed := changedRows(t.data, data)
+	t.data = data
+	t.watch.changed = changed
+	t.watch.polled = time.Now()
+	t.setPager(next, loaded)
+
+	for r := range changed {
+		if k := t.rowKey(data, r); k != "" {
+			t.details.delete(k)
+		}
+	}
+
+	if t.filtered() {
+		if t.mode == filterColumn {
+			t.matches, _ = t.data.Filter(t.query)
+		} else {
+			t.matches = t.data.Search(t.query)
+		}
+	}
+	if loaded != nil {
+		if text := loaded(t.data, next != nil); text != "" {
+			t.footerText = text
+		}
+	}
+
+	t.render(t.data)
+	for i := 1; i < t.view.GetRowCount(); i++ {
+		if k := t.rowKey(t.data, t.dataRow(i)); k != "" && k == key {
+			t.view.Select(i, 0)
+			break
+		}
+	}
+	t.scheduleDetail()
+	t.updateFooter()
+
+	if len(changed) > 0 {
+		var buf strings.Builder
+		t.notify(&buf, changed)
+		t.watch.pending = buf.String()
+	}
+}
+
+// findRow returns the data row that holds the row the action started with, matched by the key if
+// the data has a key column, as the rows may have been fetched again meanwhile. It returns -1 if
+// the row is gone.
+func (t *Table) findRow(r int, row []string) int {
+	c := t.data.GetIndex(keyColumn)
+	if c == -1 || c >= len(row) {
+		if r > 0 && r < len(t.data) {
+			return r
+		}
+		return -1
+	}
+	if r > 0 && r < len(t.data) && t.data[r][c] == row[c] {
+		return r
+	}
+	for i := 1; i < len(t.data); i++ {
+		if t.data[i][c] == row[c] {
+			return i
+		}
+	}
+	return -1
+}
+
+// rowKey returns the key of the data row, if the data has a key column.
+func (t *Table) rowKey(data TableData, r int) string {
+	c := data.GetIndex(keyColumn)
+	if c == -1 || r < 1 || r >= len(data) {
+		return ""
+	}
+	return data[r][c]
+}
+
+// isChanged checks if the row changed since the previous poll.
+func (t *Table) isChanged(r int) bool {
+	return t.watch.changed[r]
+}
+
+// watchStatus returns the watch status shown in the footer.
+func (t *Table) watchStatus() string {
+	if !t.watching() {
+		return ""
+	}
+	s := fmt.Sprintf(" · watching every %s, last updated at %s", t.watch.interval, t.watch.polled.Format("15:04:05"))
+	if n := len(t.watch.changed); n > 0 {
+		s += fmt.Sprintf(" (%d changed)", n)
+	}
+	return s
+}
+
+// notify emits the notification for the changed rows.
+func (t *Table) notify(w io.Writer, changed map[int]bool) {
+	keys := make([]string, 0, len(changed))
+	for r := 1; r < len(t.data); r++ {
+		if k := t.rowKey(t.data, r); changed[r] && k != "" {
+			keys = append(keys, k)
+		}
+	}
+	msg := fmt.Sprintf("%s changed", plural(len(changed), "issue"))
+	if len(keys) > 0 {
+		msg += ": " + strings.Join(keys, ", ")
+	}
+
+	switch t.watch.notify {
+	case NotifyBell:
+		_, _ = io.WriteString(w, "\a")
+	case NotifyOSC9:
+		_, _ = fmt.Fprintf(w, "\x1b]9;%s\a", sanitize(msg))
+	}
+}
+
+// changedRows returns the rows of the next data that are not in the previous data or whose watched
+// columns changed. The rows are matched by the key column and all columns are compared if none is watched.
+func changedRows(prev, next TableData) map[int]bool {
+	changed := make(map[int]bool)
+	if len(prev) == 0 || len(next) == 0 {
+		return changed
+	}
+
+	key, prevKey := next.GetIndex(keyColumn), prev.GetIndex(keyColumn)
+	if key == -1 || prevKey == -1 {
+		return changed
+	}
+
+	var cols []int
+	for _, name := range watchedColumns {
+		if c := next.GetIndex(name); c != -1 && prev.GetIndex(name) != -1 {
+			cols = append(cols, c)
+		}
+	}
+
+	rows := make(map[string][]string, len(prev)-1)
+	for _, row := range prev[1:] {
+		rows[row[prevKey]] = row
+	}
+
+	for r, row := range next[1:] {
+		old, ok := rows[row[key]]
+		if !ok {
+			changed[r+1] = true
+			continue
+		}
+		if cols == nil {
+			if strings.Join(old, "\x00") != strings.Join(row, "\x00") {
+				changed[r+1] = true
+			}
+			continue
+		}
+		for _, c := range cols {
+			if old[prev.GetIndex(next[0][c])] != row[c] {
+				changed[r+1] = true
+				break
+			}
+		}
+	}
+	return changed
+}
+
+// sanitize removes the control characters that would end the escape sequence early.
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
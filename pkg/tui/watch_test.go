@@ -0,0 +1,168 @@
+package tui
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangedRows(t *testing.T) {
+	t.Parallel()
+
+	prev := TableData{
+		{"KEY", "SUMMARY", "STATUS", "ASSIGNEE", "UPDATED"},
+		{"TEST-1", "One", "To Do", "", "2024-01-01 10:00:00"},
+		{"TEST-2", "Two", "To Do", "Ana", "2024-01-01 10:00:00"},
+		{"TEST-3", "Three", "Done", "Ana", "2024-01-01 10:00:00"},
+	}
+
+	cases := []struct {
+		name     string
+		prev     TableData
+		next     TableData
+		expected map[int]bool
+	}{
+		{
+			name:     "it returns nothing if the rows are unchanged",
+			prev:     prev,
+			next:     prev,
+			expected: map[int]bool{},
+		},
+		{
+			name: "it returns the new rows and the rows whose watched columns changed",
+			prev: prev,
+			next: TableData{
+				{"KEY", "SUMMARY", "STATUS", "ASSIGNEE", "UPDATED"},
+				{"TEST-4", "Four", "To Do", "", "2024-01-01 11:00:00"},
+				{"TEST-3", "Three", "Done", "Ana", "2024-01-01 10:00:00"},
+				{"TEST-2", "Two", "In Progress", "Ana", "2024-01-01 10:00:00"},
+				{"TEST-1", "One", "To Do", "Bob", "2024-01-01 10:00:00"},
+			},
+			expected: map[int]bool{1: true, 3: true, 4: true},
+		},
+		{
+			name: "it ignores the columns that are not watched",
+			prev: prev,
+			next: TableData{
+				{"KEY", "SUMMARY", "STATUS", "ASSIGNEE", "UPDATED"},
+				{"TEST-1", "Renamed", "To Do", "", "2024-01-01 10:00:00"},
+			},
+			expected: map[int]bool{},
+		},
+		{
+			name:     "it compares all columns if none is watched",
+			prev:     TableData{{"KEY", "SUMMARY"}, {"TEST-1", "One"}, {"TEST-2", "Two"}},
+			next:     TableData{{"KEY", "SUMMARY"}, {"TEST-1", "One"}, {"TEST-2", "Renamed"}},
+			expected: map[int]bool{2: true},
+		},
+		{
+			name:     "it returns nothing without the key column",
+			prev:     TableData{{"SUMMARY"}, {"One"}},
+			next:     TableData{{"SUMMARY"}, {"Two"}},
+			expected: map[int]bool{},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, changedRows(tc.prev, tc.next))
+		})
+	}
+}
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+
+	data := TableData{{"KEY", "STATUS"}, {"TEST-1", "Done"}, {"TEST-2", "To Do"}, {"TEST-3", "Done"}}
+	changed := map[int]bool{1: true, 3: true}
+
+	var buf bytes.Buffer
+
+	tbl := &Table{data: data, watch: watcher{notify: NotifyOSC9}}
+	tbl.notify(&buf, changed)
+	assert.Equal(t, "\x1b]9;2 issues changed: TEST-1, TEST-3\a", buf.String())
+
+	buf.Reset()
+	tbl.watch.notify = NotifyBell
+	tbl.notify(&buf, changed)
+	assert.Equal(t, "\a", buf.String())
+
+	buf.Reset()
+	tbl.watch.notify = ""
+	tbl.notify(&buf, changed)
+	assert.Empty(t, buf.String())
+}
+
+func TestFindRow(t *testing.T) {
+	t.Parallel()
+
+	data := TableData{
+		{"KEY", "STATUS"},
+		{"TEST-3", "Done"},
+		{"TEST-1", "To Do"},
+	}
+	noKey := TableData{
+		{"SUMMARY", "STATUS"},
+		{"One", "To Do"},
+	}
+
+	cases := []struct {
+		name     string
+		data     TableData
+		r        int
+		row      []string
+		expected int
+	}{
+		{
+			name:     "it returns the same row if it still holds the key",
+			data:     data,
+			r:        2,
+			row:      []string{"TEST-1", "To Do"},
+			expected: 2,
+		},
+		{
+			name:     "it finds the row by the key if the rows were fetched again",
+			data:     data,
+			r:        2,
+			row:      []string{"TEST-3", "In Progress"},
+			expected: 1,
+		},
+		{
+			name:     "it returns -1 if the row is gone",
+			data:     data,
+			r:        5,
+			row:      []string{"TEST-2", "To Do"},
+			expected: -1,
+		},
+		{
+			name:     "it returns the same row if the data has no key column",
+			data:     noKey,
+			r:        1,
+			row:      []string{"Two", "Done"},
+			expected: 1,
+		},
+		{
+			name:     "it returns -1 if the row is out of range and the data has no key column",
+			data:     noKey,
+			r:        2,
+			row:      []string{"Two", "Done"},
+			expected: -1,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tbl := &Table{data: tc.data}
+			assert.Equal(t, tc.expected, tbl.findRow(tc.r, tc.row))
+		})
+	}
+}